@@ -0,0 +1,116 @@
+package benchmark
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// cacheKey hashes everything that determines a (config, issue) pair's
+// outcome: the config directory's contents, the issue's identity/repo/
+// prompt, and the claude binary's version. A cache entry is invalidated
+// exactly when one of those changes, which also means copying OutputDir/
+// cache between machines is safe as long as the config and claude binary
+// match.
+func (r *BenchmarkRunner) cacheKey(cfg *Config, issue *Issue) (string, error) {
+	h := sha256.New()
+
+	if cfg.Path != "" {
+		if err := hashDir(h, cfg.Path); err != nil {
+			return "", fmt.Errorf("hash config: %w", err)
+		}
+	}
+	fmt.Fprintf(h, "issue:%s\nrepo:%s@%s\nprompt:%s\n", issue.ID, issue.RepoURL, issue.RepoRef, issue.Prompt)
+
+	version, err := r.claudeVersion()
+	if err != nil {
+		return "", fmt.Errorf("claude version: %w", err)
+	}
+	fmt.Fprintf(h, "claude:%s\n", version)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashDir feeds every file under dir into h, keyed by its path relative to
+// dir, in filepath.Walk's stable lexical order.
+func hashDir(h io.Writer, dir string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(h, "file:%s\n", filepath.ToSlash(relPath))
+		h.Write(data)
+		return nil
+	})
+}
+
+// claudeVersion runs "<ClaudeBinary> --version" once per BenchmarkRunner
+// and caches the result, since cacheKey calls it for every (config, issue)
+// pair across potentially many worker goroutines.
+func (r *BenchmarkRunner) claudeVersion() (string, error) {
+	r.claudeVersionOnce.Do(func() {
+		out, err := exec.Command(r.ClaudeBinary, "--version").Output()
+		if err != nil {
+			r.claudeVersionErr = err
+			return
+		}
+		r.claudeVersionValue = strings.TrimSpace(string(out))
+	})
+	return r.claudeVersionValue, r.claudeVersionErr
+}
+
+// cachePath returns the on-disk path an IssueResult for key is stored at.
+func (r *BenchmarkRunner) cachePath(key string) string {
+	return filepath.Join(r.OutputDir, "cache", key+".json")
+}
+
+// loadCachedResult reads back a previously cached IssueResult, reporting
+// false on any error (missing file, corrupt JSON) so callers just fall
+// through to re-running the pair.
+func (r *BenchmarkRunner) loadCachedResult(key string) (*IssueResult, bool) {
+	data, err := os.ReadFile(r.cachePath(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var result IssueResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, false
+	}
+	return &result, true
+}
+
+// saveCachedResult stores result under key so a future Run (on this
+// machine, or another after copying OutputDir/cache) can skip re-running
+// this (config, issue) pair.
+func (r *BenchmarkRunner) saveCachedResult(key string, result *IssueResult) error {
+	path := r.cachePath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
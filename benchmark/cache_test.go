@@ -0,0 +1,98 @@
+package benchmark
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCachePath(t *testing.T) {
+	r := &BenchmarkRunner{OutputDir: "/tmp/out"}
+	got := r.cachePath("abc123")
+	want := filepath.Join("/tmp/out", "cache", "abc123.json")
+	if got != want {
+		t.Errorf("cachePath(%q) = %q, want %q", "abc123", got, want)
+	}
+}
+
+func TestSaveAndLoadCachedResultRoundTrip(t *testing.T) {
+	r := &BenchmarkRunner{OutputDir: t.TempDir()}
+
+	original := &IssueResult{IssueID: "issue-1", ConfigName: "baseline", Success: true, Score: 0.9}
+	if err := r.saveCachedResult("mykey", original); err != nil {
+		t.Fatalf("saveCachedResult: %v", err)
+	}
+
+	loaded, ok := r.loadCachedResult("mykey")
+	if !ok {
+		t.Fatal("loadCachedResult reported no entry for a key that was just saved")
+	}
+	if loaded.IssueID != original.IssueID || loaded.Score != original.Score || loaded.Success != original.Success {
+		t.Errorf("loadCachedResult() = %+v, want %+v", loaded, original)
+	}
+}
+
+func TestLoadCachedResultMissingOrCorrupt(t *testing.T) {
+	r := &BenchmarkRunner{OutputDir: t.TempDir()}
+
+	if _, ok := r.loadCachedResult("never-saved"); ok {
+		t.Error("loadCachedResult() on a never-saved key = true, want false")
+	}
+
+	path := r.cachePath("corrupt")
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := r.loadCachedResult("corrupt"); ok {
+		t.Error("loadCachedResult() on corrupt JSON = true, want false")
+	}
+}
+
+// TestCacheKeyChangesWithConfigContents checks that cacheKey's hash reacts
+// to the config directory's file contents, not just its path - editing a
+// config file must invalidate the cache for every issue that used it.
+// ClaudeBinary is set to "echo" so claudeVersion has something deterministic
+// to shell out to without depending on the real claude CLI being installed.
+func TestCacheKeyChangesWithConfigContents(t *testing.T) {
+	cfgDir := t.TempDir()
+	cfgFile := filepath.Join(cfgDir, "CLAUDE.md")
+	if err := os.WriteFile(cfgFile, []byte("version 1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &BenchmarkRunner{ClaudeBinary: "echo"}
+	cfg := &Config{Name: "test-config", Path: cfgDir}
+	issue := &Issue{ID: "issue-1", RepoURL: "https://example.com/repo.git", RepoRef: "main", Prompt: "fix the bug"}
+
+	key1, err := r.cacheKey(cfg, issue)
+	if err != nil {
+		t.Fatalf("cacheKey: %v", err)
+	}
+
+	if err := os.WriteFile(cfgFile, []byte("version 2"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	key2, err := r.cacheKey(cfg, issue)
+	if err != nil {
+		t.Fatalf("cacheKey: %v", err)
+	}
+
+	if key1 == key2 {
+		t.Error("cacheKey did not change after the config file's contents changed")
+	}
+
+	// Same contents again should reproduce the original key deterministically.
+	if err := os.WriteFile(cfgFile, []byte("version 1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	key3, err := r.cacheKey(cfg, issue)
+	if err != nil {
+		t.Fatalf("cacheKey: %v", err)
+	}
+	if key3 != key1 {
+		t.Error("cacheKey is not deterministic for identical inputs")
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/MateoSegura/.claude/benchmark/lint"
+)
+
+// lintCmd implements `bench lint <config-path>`: run the static config
+// checks standalone, without spinning up a benchmark run.
+func lintCmd(args []string) {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	failOn := fs.String("fail-on", "error", "Exit non-zero if a finding at or above this severity fires (error, warning, info)")
+	sarifOut := fs.String("sarif", "", "Write a SARIF 2.1.0 log to this path, for CI code-scanning consumption")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: bench lint [--fail-on=error|warning|info] [--sarif=path] <config-path>")
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+	configPath := fs.Arg(0)
+
+	threshold, err := lint.ParseSeverity(*failOn)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	diags, err := lint.Lint(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error linting %s: %v\n", configPath, err)
+		os.Exit(1)
+	}
+
+	lint.PrintReport(configPath, diags)
+
+	if *sarifOut != "" {
+		if err := lint.SaveSARIF(diags, *sarifOut); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing SARIF: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("SARIF report written to: %s\n", *sarifOut)
+	}
+
+	if lint.FailsThreshold(diags, threshold) {
+		os.Exit(1)
+	}
+}
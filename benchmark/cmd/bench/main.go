@@ -17,6 +17,12 @@
 //
 //	# Compare multiple configs
 //	go run ./benchmark/cmd/bench --config /path/to/config1 --config /path/to/config2
+//
+//	# Browse issues and watch runs live instead of a stdout report
+//	go run ./benchmark/cmd/bench --config /path/to/.claude --interactive
+//
+//	# Statically validate a config without running it
+//	go run ./benchmark/cmd/bench lint /path/to/.claude
 package main
 
 import (
@@ -25,9 +31,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/alecthomas/chroma/v2/styles"
+
 	"github.com/MateoSegura/.claude/benchmark"
+	"github.com/MateoSegura/.claude/benchmark/cmd/bench/tui"
+	"github.com/MateoSegura/.claude/benchmark/htmlreport"
+	"github.com/MateoSegura/.claude/benchmark/lint"
 )
 
 type stringSlice []string
@@ -41,7 +53,31 @@ func (s *stringSlice) Set(value string) error {
 	return nil
 }
 
+// chromaStylesCmd implements `bench chromastyles`: it lists every style
+// CodeView's Style field and bench's --style flag accept, the terminal
+// equivalent of Hugo's CSS stylesheet-gen command.
+func chromaStylesCmd() {
+	names := styles.Names()
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Println(name)
+	}
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "chromastyles" {
+		chromaStylesCmd()
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "profile" {
+		profileCmd(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "lint" {
+		lintCmd(os.Args[2:])
+		return
+	}
+
 	// Flags
 	var configs stringSlice
 	var difficulty string
@@ -51,7 +87,13 @@ func main() {
 	var dryRun bool
 	var baseline bool
 	var outputDir string
-	var issueID string
+	var issueIDs stringSlice
+	var interactive bool
+	var codeStyle string
+	var htmlOut string
+	var profileName string
+	var noLint bool
+	var lintFailOn string
 
 	flag.Var(&configs, "config", "Path to .claude config directory (can specify multiple)")
 	flag.StringVar(&difficulty, "difficulty", "", "Filter by difficulty (easy, medium, hard)")
@@ -61,7 +103,14 @@ func main() {
 	flag.BoolVar(&dryRun, "dry-run", false, "Validate corpus without running Claude")
 	flag.BoolVar(&baseline, "baseline", true, "Include baseline (no config) for comparison")
 	flag.StringVar(&outputDir, "output", "/tmp/claude-benchmark/results", "Output directory for results")
-	flag.StringVar(&issueID, "issue", "", "Run only a specific issue by ID")
+	flag.Var(&issueIDs, "issue", "Run only a specific issue by ID (can specify multiple)")
+	flag.BoolVar(&interactive, "interactive", false, "Launch a Bubble Tea TUI to browse issues and watch runs live instead of printing a report")
+	flag.BoolVar(&interactive, "it", false, "Shorthand for -interactive")
+	flag.StringVar(&codeStyle, "style", tui.DefaultCodeStyle, "Chroma style for --interactive's code viewer (see `bench chromastyles` for the full list)")
+	flag.StringVar(&htmlOut, "html-out", "", "Write a multi-page HTML report with a per-issue drilldown to this directory")
+	flag.StringVar(&profileName, "profile", "", "Load flag defaults from a named profile (see `bench profile save`); explicit flags still win")
+	flag.BoolVar(&noLint, "no-lint", false, "Skip the static config lint that normally runs against every --config before the benchmark starts")
+	flag.StringVar(&lintFailOn, "fail-on", "error", "Abort before running if a lint finding at or above this severity fires (error, warning, info); see `bench lint`")
 
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage: %s [flags] [corpus-path]\n\n", os.Args[0])
@@ -81,6 +130,44 @@ func main() {
 
 	flag.Parse()
 
+	if profileName != "" {
+		explicit := map[string]bool{}
+		flag.Visit(func(f *flag.Flag) { explicit[f.Name] = true })
+
+		settings, err := benchmark.LoadSettings()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading settings: %v\n", err)
+			os.Exit(1)
+		}
+		profile, ok := settings.Profiles[profileName]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: no profile named %q (see `bench profile list`)\n", profileName)
+			os.Exit(1)
+		}
+
+		if !explicit["config"] && len(profile.Configs) > 0 {
+			configs = profile.Configs
+		}
+		if !explicit["difficulty"] && profile.Difficulty != "" {
+			difficulty = profile.Difficulty
+		}
+		if !explicit["task"] && profile.TaskType != "" {
+			taskType = profile.TaskType
+		}
+		if !explicit["language"] && profile.Language != "" {
+			language = profile.Language
+		}
+		if !explicit["issue"] && len(profile.IssueIDs) > 0 {
+			issueIDs = profile.IssueIDs
+		}
+		if !explicit["output"] && profile.OutputDir != "" {
+			outputDir = profile.OutputDir
+		}
+		if !explicit["verbose"] && profile.Verbose {
+			verbose = profile.Verbose
+		}
+	}
+
 	// Determine corpus path
 	corpusPath := "./benchmark/corpus"
 	if flag.NArg() > 0 {
@@ -127,16 +214,21 @@ func main() {
 	// Filter issues
 	issues := corpus.Filter(diffFilter, taskFilter, langFilter, nil)
 
-	// Filter by specific issue ID if provided
-	if issueID != "" {
+	// Filter by specific issue ID(s) if provided
+	if len(issueIDs) > 0 {
+		want := make(map[string]bool, len(issueIDs))
+		for _, id := range issueIDs {
+			want[id] = true
+		}
+
 		var filtered []*benchmark.Issue
 		for _, issue := range issues {
-			if issue.ID == issueID {
+			if want[issue.ID] {
 				filtered = append(filtered, issue)
 			}
 		}
 		if len(filtered) == 0 {
-			fmt.Fprintf(os.Stderr, "Error: issue not found: %s\n", issueID)
+			fmt.Fprintf(os.Stderr, "Error: no matching issue(s): %s\n", strings.Join(issueIDs, ", "))
 			os.Exit(1)
 		}
 		issues = filtered
@@ -207,6 +299,37 @@ func main() {
 		fmt.Printf("  - %s (%s)\n", name, cfgPath)
 	}
 
+	// Implicit lint pre-flight: a malformed config should fail in
+	// milliseconds, not after a full corpus run burns Claude API budget
+	// only to come back looking like a regression.
+	if !noLint && len(configs) > 0 {
+		threshold, err := lint.ParseSeverity(lintFailOn)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		failed := false
+		for _, cfg := range runner.Configs {
+			if cfg.Path == "" {
+				continue // baseline has no .claude dir to lint
+			}
+			diags, err := lint.Lint(cfg.Path)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error linting %s: %v\n", cfg.Path, err)
+				os.Exit(1)
+			}
+			lint.PrintReport(cfg.Name, diags)
+			if lint.FailsThreshold(diags, threshold) {
+				failed = true
+			}
+		}
+		if failed {
+			fmt.Fprintf(os.Stderr, "\nLint findings at or above %q; aborting before the run (use --no-lint to skip, or --fail-on to change the threshold).\n", lintFailOn)
+			os.Exit(1)
+		}
+	}
+
 	if len(runner.Configs) == 0 {
 		fmt.Println("\nNo configs specified. Use --config to add configurations to test.")
 		fmt.Println("Running with baseline only.")
@@ -215,6 +338,17 @@ func main() {
 
 	fmt.Println()
 
+	// Interactive mode hands off to the Bubble Tea TUI for iterative config
+	// tuning - it browses/runs/compares issues itself instead of the
+	// single filtered-corpus run below.
+	if interactive {
+		if err := tui.Run(runner, filteredCorpus, codeStyle); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running interactive mode: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Dry run mode
 	if dryRun {
 		fmt.Println("DRY RUN MODE - validating corpus structure only\n")
@@ -240,6 +374,14 @@ func main() {
 	// Print report
 	result.PrintReport()
 
+	if htmlOut != "" {
+		if err := htmlreport.New(result).Write(htmlOut); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing HTML report: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("HTML report written to: %s\n", htmlOut)
+	}
+
 	// Print comparison if we have baseline and other configs
 	if baseline && len(configs) > 0 {
 		for _, cfg := range configs {
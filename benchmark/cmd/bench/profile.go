@@ -0,0 +1,176 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MateoSegura/.claude/benchmark"
+)
+
+// profileCmd implements `bench profile save|load|list|delete`, the
+// subcommands backing --profile.
+func profileCmd(args []string) {
+	if len(args) == 0 {
+		profileUsage()
+		os.Exit(1)
+	}
+
+	switch args[0] {
+	case "save":
+		profileSaveCmd(args[1:])
+	case "load":
+		profileLoadCmd(args[1:])
+	case "list":
+		profileListCmd()
+	case "delete":
+		profileDeleteCmd(args[1:])
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown profile subcommand %q\n\n", args[0])
+		profileUsage()
+		os.Exit(1)
+	}
+}
+
+func profileUsage() {
+	fmt.Fprintln(os.Stderr, `Usage: bench profile <save|load|list|delete> [flags]
+
+  bench profile save <name> [--config ...] [--difficulty ...] [--task ...]
+                             [--language ...] [--issue ...] [--output ...] [--verbose]
+  bench profile load <name>
+  bench profile list
+  bench profile delete <name>`)
+}
+
+// profileSaveCmd parses the same flag set as the main run (minus
+// run-only flags like --interactive) and stores it as a named profile.
+func profileSaveCmd(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: profile save requires a name")
+		os.Exit(1)
+	}
+	name := args[0]
+
+	fs := flag.NewFlagSet("profile save", flag.ExitOnError)
+	var configs stringSlice
+	var issueIDs stringSlice
+	var difficulty, taskType, language, outputDir string
+	var verbose bool
+
+	fs.Var(&configs, "config", "Path to .claude config directory (can specify multiple)")
+	fs.StringVar(&difficulty, "difficulty", "", "Filter by difficulty (easy, medium, hard)")
+	fs.StringVar(&taskType, "task", "", "Filter by task type (bug_fix, feature, refactor, test)")
+	fs.StringVar(&language, "language", "", "Filter by language (go, typescript, python)")
+	fs.Var(&issueIDs, "issue", "Run only a specific issue by ID (can specify multiple)")
+	fs.StringVar(&outputDir, "output", "", "Output directory for results")
+	fs.BoolVar(&verbose, "verbose", false, "Verbose output")
+	fs.Parse(args[1:])
+
+	settings, err := benchmark.LoadSettings()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading settings: %v\n", err)
+		os.Exit(1)
+	}
+
+	profile := benchmark.Profile{
+		Configs:    configs,
+		Difficulty: difficulty,
+		TaskType:   taskType,
+		Language:   language,
+		IssueIDs:   issueIDs,
+		OutputDir:  outputDir,
+		Verbose:    verbose,
+	}
+	if err := settings.SaveProfile(name, profile); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving profile: %v\n", err)
+		os.Exit(1)
+	}
+
+	path, _ := benchmark.SettingsPath()
+	fmt.Printf("Saved profile %q to %s\n", name, path)
+}
+
+// profileLoadCmd prints a profile's saved flags, the way `bench --profile
+// <name>` would apply them, for inspection.
+func profileLoadCmd(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: profile load requires a name")
+		os.Exit(1)
+	}
+	name := args[0]
+
+	settings, err := benchmark.LoadSettings()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading settings: %v\n", err)
+		os.Exit(1)
+	}
+	profile, ok := settings.Profiles[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: no profile named %q\n", name)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Profile %q:\n", name)
+	if len(profile.Configs) > 0 {
+		fmt.Printf("  --config %s\n", strings.Join(profile.Configs, ","))
+	}
+	if profile.Difficulty != "" {
+		fmt.Printf("  --difficulty %s\n", profile.Difficulty)
+	}
+	if profile.TaskType != "" {
+		fmt.Printf("  --task %s\n", profile.TaskType)
+	}
+	if profile.Language != "" {
+		fmt.Printf("  --language %s\n", profile.Language)
+	}
+	if len(profile.IssueIDs) > 0 {
+		fmt.Printf("  --issue %s\n", strings.Join(profile.IssueIDs, ","))
+	}
+	if profile.OutputDir != "" {
+		fmt.Printf("  --output %s\n", profile.OutputDir)
+	}
+	if profile.Verbose {
+		fmt.Printf("  --verbose\n")
+	}
+}
+
+func profileListCmd() {
+	settings, err := benchmark.LoadSettings()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading settings: %v\n", err)
+		os.Exit(1)
+	}
+
+	names := settings.ProfileNames()
+	if len(names) == 0 {
+		fmt.Println("No saved profiles. Create one with `bench profile save <name> [flags]`.")
+		return
+	}
+	for _, name := range names {
+		fmt.Println(name)
+	}
+}
+
+func profileDeleteCmd(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: profile delete requires a name")
+		os.Exit(1)
+	}
+	name := args[0]
+
+	settings, err := benchmark.LoadSettings()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading settings: %v\n", err)
+		os.Exit(1)
+	}
+	if _, ok := settings.Profiles[name]; !ok {
+		fmt.Fprintf(os.Stderr, "Error: no profile named %q\n", name)
+		os.Exit(1)
+	}
+	if err := settings.DeleteProfile(name); err != nil {
+		fmt.Fprintf(os.Stderr, "Error deleting profile: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Deleted profile %q\n", name)
+}
@@ -0,0 +1,193 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/benchmark"
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// browseScreen is the issue browser: the full corpus, filterable by
+// difficulty/language/task via Tab and by a fuzzy "/" search over
+// ID+Title, with Enter running the highlighted issue across every
+// configured Config.
+type browseScreen struct {
+	issues   []*benchmark.Issue
+	filtered []*benchmark.Issue
+	cursor   int
+
+	facet     facet
+	query     string
+	filtering bool
+}
+
+// facet is which structured filter Tab cycles through before falling back
+// to "no facet filter, just the fuzzy query".
+type facet int
+
+const (
+	facetNone facet = iota
+	facetDifficulty
+	facetLanguage
+	facetTaskType
+)
+
+func newBrowseScreen(issues []*benchmark.Issue) *browseScreen {
+	b := &browseScreen{issues: issues}
+	b.applyFilter()
+	return b
+}
+
+func (b *browseScreen) selected() *benchmark.Issue {
+	if b.cursor < 0 || b.cursor >= len(b.filtered) {
+		return nil
+	}
+	return b.filtered[b.cursor]
+}
+
+func (b *browseScreen) up() {
+	if b.cursor > 0 {
+		b.cursor--
+	}
+}
+
+func (b *browseScreen) down() {
+	if b.cursor < len(b.filtered)-1 {
+		b.cursor++
+	}
+}
+
+func (b *browseScreen) cycleFacet() {
+	b.facet = (b.facet + 1) % 4
+	b.applyFilter()
+}
+
+func (b *browseScreen) applyFilter() {
+	b.filtered = b.filtered[:0]
+	for _, issue := range b.issues {
+		if !b.matchesFacet(issue) {
+			continue
+		}
+		if b.query != "" {
+			if _, ok := fuzzyScore(b.query, issue.ID+" "+issue.Title); !ok {
+				continue
+			}
+		}
+		b.filtered = append(b.filtered, issue)
+	}
+	if b.cursor >= len(b.filtered) {
+		b.cursor = len(b.filtered) - 1
+	}
+	if b.cursor < 0 {
+		b.cursor = 0
+	}
+}
+
+func (b *browseScreen) matchesFacet(issue *benchmark.Issue) bool {
+	switch b.facet {
+	case facetDifficulty:
+		return issue.Difficulty == benchmark.DifficultyEasy
+	case facetLanguage:
+		return issue.Language == "go"
+	case facetTaskType:
+		return issue.TaskType == benchmark.TaskBugFix
+	default:
+		return true
+	}
+}
+
+func (b *browseScreen) facetLabel() string {
+	switch b.facet {
+	case facetDifficulty:
+		return "difficulty=easy"
+	case facetLanguage:
+		return "language=go"
+	case facetTaskType:
+		return "task=bug_fix"
+	default:
+		return "all"
+	}
+}
+
+func (b *browseScreen) render(width, height int, t *theme.Theme) string {
+	var lines []string
+	if b.filtering {
+		lines = append(lines, lipgloss.NewStyle().Foreground(t.Accent).Render("/"+b.query))
+	}
+
+	if len(b.filtered) == 0 {
+		lines = append(lines, lipgloss.NewStyle().Foreground(t.Muted).Render("No issues match "+b.facetLabel()))
+	}
+	for i, issue := range b.filtered {
+		if i >= height-len(lines) {
+			break
+		}
+		lines = append(lines, b.renderRow(issue, i == b.cursor, t))
+	}
+	return lipgloss.NewStyle().Width(width).Height(height).Render(strings.Join(lines, "\n"))
+}
+
+func (b *browseScreen) renderRow(issue *benchmark.Issue, selected bool, t *theme.Theme) string {
+	marker := "  "
+	style := lipgloss.NewStyle()
+	if selected {
+		marker = lipgloss.NewStyle().Foreground(t.Primary).Render("▸ ")
+		style = style.Bold(true)
+	}
+	meta := lipgloss.NewStyle().Foreground(t.Muted).Render(fmt.Sprintf("[%s/%s/%s]", issue.Difficulty, issue.Language, issue.TaskType))
+	return marker + style.Render(issue.ID) + "  " + issue.Title + "  " + meta
+}
+
+// fuzzyScore reports an fzf-style relevance score for query as a
+// case-insensitive subsequence of target, the same scoring
+// skills/framework-bubbletea/scaffolds/commands.go uses for its command
+// palette: bonus for matching at a word boundary, bonus for consecutive
+// matches, penalty for gaps.
+func fuzzyScore(query, target string) (int, bool) {
+	q := strings.ToLower(query)
+	t := strings.ToLower(target)
+
+	qi := 0
+	score := 0
+	lastMatch := -1
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] != q[qi] {
+			continue
+		}
+
+		bonus := 1
+		switch {
+		case ti == 0:
+			bonus += 8
+		case isWordBoundary(t, ti):
+			bonus += 6
+		}
+		if lastMatch == ti-1 {
+			bonus += 4
+		} else if lastMatch >= 0 {
+			score -= ti - lastMatch - 1
+		}
+
+		score += bonus
+		lastMatch = ti
+		qi++
+	}
+
+	if qi < len(q) {
+		return 0, false
+	}
+	return score, true
+}
+
+// isWordBoundary reports whether the byte at index i in s starts a new
+// "word": it follows a non-alphanumeric byte, or i is 0.
+func isWordBoundary(s string, i int) bool {
+	if i <= 0 {
+		return true
+	}
+	prev := s[i-1]
+	return !(prev >= 'a' && prev <= 'z' || prev >= '0' && prev <= '9')
+}
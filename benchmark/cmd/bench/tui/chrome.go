@@ -0,0 +1,164 @@
+// Package tui is the --interactive mode for the bench command: a Bubble
+// Tea program wrapping an issue browser, a live run view, and a results
+// screen in a k9s-style Chrome shell, built against benchmark.BenchmarkRunner
+// and its Progress() event stream instead of the stdout-only reporting
+// main.go uses otherwise.
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// Shortcut is one keyboard shortcut shown in Chrome's footer.
+type Shortcut struct {
+	Key  string
+	Desc string
+}
+
+// Common shortcut sets, named after the ones in
+// skills/design-tui-k9s/scaffolds/chrome.go.
+var (
+	ShortcutsNavigation = []Shortcut{
+		{Key: "↑↓", Desc: "Navigate"},
+		{Key: "Enter", Desc: "Select"},
+		{Key: "q", Desc: "Quit"},
+	}
+
+	ShortcutsBrowse = []Shortcut{
+		{Key: "↑↓", Desc: "Navigate"},
+		{Key: "/", Desc: "Filter"},
+		{Key: "Enter", Desc: "Run"},
+		{Key: "Tab", Desc: "Cycle Facet"},
+		{Key: "q", Desc: "Quit"},
+	}
+
+	ShortcutsRun = []Shortcut{
+		{Key: "↑↓", Desc: "Select config"},
+		{Key: "v", Desc: "View output"},
+		{Key: "r", Desc: "Re-run"},
+		{Key: "Esc", Desc: "Back"},
+		{Key: "q", Desc: "Quit"},
+	}
+
+	ShortcutsCodeView = []Shortcut{
+		{Key: "j/k", Desc: "Scroll"},
+		{Key: "PgUp/PgDn", Desc: "Page"},
+		{Key: "Esc", Desc: "Back"},
+	}
+
+	ShortcutsResults = []Shortcut{
+		{Key: "c", Desc: "Compare"},
+		{Key: "Esc", Desc: "Back"},
+		{Key: "q", Desc: "Quit"},
+	}
+)
+
+// ChromeConfig configures Chrome's appearance.
+type ChromeConfig struct {
+	Title   string // Left side of the header, e.g. "◆ BENCH"
+	Context string // Right side of the header, e.g. "easy/go [12]" or elapsed time + pass/fail counts
+
+	Shortcuts []Shortcut
+
+	Width  int
+	Height int
+}
+
+// Chrome renders a k9s-style header/footer around a screen's content,
+// mirroring skills/design-tui-k9s/scaffolds/chrome.go but resolving its
+// colors through a *theme.Theme (see theme.Theme.HeaderStyle/FooterStyle)
+// instead of a package-local hardcoded palette, the same way
+// skill-tests/dashboard styles its own header/footer.
+type Chrome struct {
+	config ChromeConfig
+	theme  *theme.Theme
+}
+
+// NewChrome creates a Chrome that renders with t (theme.Default() if nil).
+func NewChrome(config ChromeConfig, t *theme.Theme) Chrome {
+	if t == nil {
+		t = theme.Default()
+	}
+	return Chrome{config: config, theme: t}
+}
+
+// SetSize updates the dimensions Chrome renders at; called from the top
+// Model's Update on tea.WindowSizeMsg.
+func (c Chrome) SetSize(width, height int) Chrome {
+	c.config.Width = width
+	c.config.Height = height
+	return c
+}
+
+// SetTitle updates the header's left-side title.
+func (c Chrome) SetTitle(title string) Chrome {
+	c.config.Title = title
+	return c
+}
+
+// SetContext updates the header's right-side context string.
+func (c Chrome) SetContext(context string) Chrome {
+	c.config.Context = context
+	return c
+}
+
+// SetShortcuts updates the footer shortcuts.
+func (c Chrome) SetShortcuts(shortcuts []Shortcut) Chrome {
+	c.config.Shortcuts = shortcuts
+	return c
+}
+
+// ContentHeight returns the height left for content once the header and
+// footer bars are subtracted.
+func (c Chrome) ContentHeight() int {
+	h := c.config.Height - 2 // header + footer
+	if h < 1 {
+		h = 1
+	}
+	return h
+}
+
+// ContentWidth returns the width available to content, which is the full
+// terminal width - the header/footer bars span it edge to edge too.
+func (c Chrome) ContentWidth() int {
+	return c.config.Width
+}
+
+// Render wraps content with the header and footer bars.
+func (c Chrome) Render(content string) string {
+	header := c.renderHeader()
+	footer := c.renderFooter()
+
+	body := lipgloss.NewStyle().
+		Width(c.config.Width).
+		Height(c.ContentHeight()).
+		Render(content)
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, footer)
+}
+
+func (c Chrome) renderHeader() string {
+	left := lipgloss.NewStyle().Foreground(c.theme.Primary).Bold(true).Render(c.config.Title)
+	right := lipgloss.NewStyle().Foreground(c.theme.Muted).Render(c.config.Context)
+
+	gap := c.config.Width - lipgloss.Width(left) - lipgloss.Width(right) - 2
+	if gap < 1 {
+		gap = 1
+	}
+
+	return c.theme.HeaderStyle().Width(c.config.Width).Render(left + strings.Repeat(" ", gap) + right)
+}
+
+func (c Chrome) renderFooter() string {
+	var parts []string
+	for _, s := range c.config.Shortcuts {
+		key := c.theme.ShortcutKeyStyle().Render("<" + s.Key + ">")
+		desc := lipgloss.NewStyle().Foreground(c.theme.Muted).Render(s.Desc)
+		parts = append(parts, key+desc)
+	}
+	return c.theme.FooterStyle().Width(c.config.Width).Render(strings.Join(parts, "  "))
+}
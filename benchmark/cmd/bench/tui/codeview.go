@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"os"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// DefaultCodeStyle is the Chroma style CodeView falls back to when its
+// config leaves Style empty - monokai's gold/orange accents read closest
+// to this package's existing gold/cyan Chrome palette of any built-in
+// Chroma style.
+const DefaultCodeStyle = "monokai"
+
+// CodeViewConfig configures a CodeView.
+type CodeViewConfig struct {
+	// Language is a Chroma lexer name or alias (e.g. "go", "typescript",
+	// "json"); empty falls back to FilenameHint, then to a content-based
+	// guess.
+	Language string
+	// FilenameHint is consulted by lexers.Match when Language is empty,
+	// e.g. "fix.diff" to select the diff lexer.
+	FilenameHint string
+	// Style is a Chroma style name (see styles.Names / `bench
+	// chromastyles`); empty means DefaultCodeStyle.
+	Style string
+
+	Width  int
+	Height int
+}
+
+// CodeView renders syntax-highlighted source (or a diff, or a JSON
+// payload) inside a Chrome-wrapped screen: scrollable with j/k/PgUp/PgDn,
+// sized to Chrome.ContentWidth()/ContentHeight(), and falling back to
+// plain text when NO_COLOR is set or the terminal reports fewer than 256
+// colors.
+type CodeView struct {
+	config CodeViewConfig
+	lines  []string
+	offset int
+}
+
+// NewCodeView highlights source per config and returns a CodeView ready to
+// Render at offset 0.
+func NewCodeView(source string, config CodeViewConfig) CodeView {
+	highlighted := highlight(source, config)
+	return CodeView{config: config, lines: strings.Split(highlighted, "\n")}
+}
+
+// SetSize updates the viewport dimensions.
+func (v CodeView) SetSize(width, height int) CodeView {
+	v.config.Width = width
+	v.config.Height = height
+	return v
+}
+
+// ScrollUp/ScrollDown move the viewport by n lines, clamped to the content.
+func (v CodeView) ScrollUp(n int) CodeView   { return v.scroll(-n) }
+func (v CodeView) ScrollDown(n int) CodeView { return v.scroll(n) }
+
+// PageUp/PageDown move the viewport by a full screen height.
+func (v CodeView) PageUp() CodeView   { return v.scroll(-v.pageSize()) }
+func (v CodeView) PageDown() CodeView { return v.scroll(v.pageSize()) }
+
+func (v CodeView) pageSize() int {
+	if v.config.Height < 1 {
+		return 1
+	}
+	return v.config.Height
+}
+
+func (v CodeView) scroll(delta int) CodeView {
+	v.offset += delta
+	if max := len(v.lines) - v.pageSize(); v.offset > max {
+		v.offset = max
+	}
+	if v.offset < 0 {
+		v.offset = 0
+	}
+	return v
+}
+
+// Render returns the visible window of highlighted lines, padded to
+// Width/Height.
+func (v CodeView) Render() string {
+	end := v.offset + v.pageSize()
+	if end > len(v.lines) {
+		end = len(v.lines)
+	}
+	start := v.offset
+	if start > end {
+		start = end
+	}
+
+	visible := strings.Join(v.lines[start:end], "\n")
+	return lipgloss.NewStyle().Width(v.config.Width).Height(v.config.Height).Render(visible)
+}
+
+// highlight resolves a lexer and formatter for config and returns source
+// rendered through Chroma, or source unchanged when color is unavailable
+// (NO_COLOR, or a terminal reporting fewer than 256 colors).
+func highlight(source string, config CodeViewConfig) string {
+	formatter := terminalFormatter()
+	if formatter == nil {
+		return source
+	}
+
+	lexer := resolveLexer(config, source)
+	styleName := config.Style
+	if styleName == "" {
+		styleName = DefaultCodeStyle
+	}
+	style := styles.Get(styleName)
+	if style == nil {
+		style = styles.Fallback
+	}
+
+	iterator, err := lexer.Tokenise(nil, source)
+	if err != nil {
+		return source
+	}
+
+	var sb strings.Builder
+	if err := formatter.Format(&sb, style, iterator); err != nil {
+		return source
+	}
+	return sb.String()
+}
+
+func resolveLexer(config CodeViewConfig, source string) chroma.Lexer {
+	var lexer chroma.Lexer
+	if config.Language != "" {
+		lexer = lexers.Get(config.Language)
+	}
+	if lexer == nil && config.FilenameHint != "" {
+		lexer = lexers.Match(config.FilenameHint)
+	}
+	if lexer == nil {
+		lexer = lexers.Analyse(source)
+	}
+	if lexer == nil {
+		lexer = lexers.Fallback
+	}
+	return chroma.Coalesce(lexer)
+}
+
+// terminalFormatter picks the highest-fidelity Chroma terminal formatter
+// this terminal supports, or nil when NO_COLOR is set or the terminal
+// reports fewer than 256 colors - CodeView's caller then renders source
+// plain instead.
+func terminalFormatter() chroma.Formatter {
+	if os.Getenv("NO_COLOR") != "" {
+		return nil
+	}
+	switch termenv.ColorProfile() {
+	case termenv.TrueColor:
+		return formatters.TTY16m
+	case termenv.ANSI256:
+		return formatters.TTY256
+	default:
+		return nil
+	}
+}
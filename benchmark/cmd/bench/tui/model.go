@@ -0,0 +1,249 @@
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/MateoSegura/.claude/benchmark"
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// screen is which of the three panes model is currently showing, driven by
+// the shortcut bar the way k9s-style scaffolds switch views.
+type screen int
+
+const (
+	screenBrowse screen = iota
+	screenRun
+	screenResults
+)
+
+// model is the top-level Bubble Tea model for `bench --interactive`: a
+// Chrome shell around whichever of browseScreen/runScreen/resultsScreen is
+// active.
+type model struct {
+	runner    *benchmark.BenchmarkRunner
+	theme     *theme.Theme
+	chrome    Chrome
+	codeStyle string
+
+	current screen
+	browse  *browseScreen
+	run     *runScreen
+	results *resultsScreen
+
+	width, height int
+}
+
+// New creates the interactive model over corpus's issues, driving runs
+// through runner. codeStyle is the Chroma style name <v> opens CodeView
+// with; empty means DefaultCodeStyle.
+func New(runner *benchmark.BenchmarkRunner, corpus *benchmark.Corpus, codeStyle string) model {
+	t := theme.Default()
+	return model{
+		runner:    runner,
+		theme:     t,
+		chrome:    NewChrome(ChromeConfig{Title: "◆ BENCH", Shortcuts: ShortcutsBrowse}, t),
+		codeStyle: codeStyle,
+		current:   screenBrowse,
+		browse:    newBrowseScreen(corpus.Issues),
+	}
+}
+
+// Run discovers nothing itself - corpus filtering is the caller's job, the
+// same way main.go filters before deciding whether to launch the
+// non-interactive path - and blocks running the TUI until the user quits.
+func Run(runner *benchmark.BenchmarkRunner, corpus *benchmark.Corpus, codeStyle string) error {
+	_, err := tea.NewProgram(New(runner, corpus, codeStyle), tea.WithAltScreen()).Run()
+	return err
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.chrome = m.chrome.SetSize(msg.Width, msg.Height)
+		return m, nil
+
+	case progressMsg:
+		if m.run != nil {
+			m.run.applyProgress(msg.ev)
+		}
+		return m, waitForProgress(msg.ch)
+
+	case runDoneMsg:
+		if m.run != nil {
+			m.run.applyDone(msg)
+			m.results = newResultsScreen(msg.result, m.configNames())
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+func (m model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.current == screenBrowse && m.browse.filtering {
+		return m.handleFilterKey(msg)
+	}
+
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	}
+
+	switch m.current {
+	case screenBrowse:
+		return m.handleBrowseKey(msg)
+	case screenRun:
+		return m.handleRunKey(msg)
+	case screenResults:
+		return m.handleResultsKey(msg)
+	}
+	return m, nil
+}
+
+func (m model) handleFilterKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyEnter:
+		m.browse.filtering = false
+	case tea.KeyBackspace:
+		if n := len(m.browse.query); n > 0 {
+			m.browse.query = m.browse.query[:n-1]
+			m.browse.applyFilter()
+		}
+	case tea.KeyRunes:
+		m.browse.query += string(msg.Runes)
+		m.browse.applyFilter()
+	}
+	return m, nil
+}
+
+func (m model) handleBrowseKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		m.browse.up()
+	case "down", "j":
+		m.browse.down()
+	case "/":
+		m.browse.filtering = true
+	case "tab":
+		m.browse.cycleFacet()
+	case "enter":
+		if issue := m.browse.selected(); issue != nil {
+			m.run = newRunScreen(issue, m.configNames())
+			m.current = screenRun
+			m.chrome = m.chrome.SetShortcuts(ShortcutsRun)
+			return m, tea.Batch(startRun(m.runner, issue), waitForProgress(m.runner.Progress()))
+		}
+	}
+	return m, nil
+}
+
+func (m model) handleRunKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.run.code != nil {
+		switch msg.String() {
+		case "esc":
+			m.run.closeCode()
+			m.chrome = m.chrome.SetShortcuts(ShortcutsRun)
+		case "j", "down":
+			*m.run.code = m.run.code.ScrollDown(1)
+		case "k", "up":
+			*m.run.code = m.run.code.ScrollUp(1)
+		case "pgdown":
+			*m.run.code = m.run.code.PageDown()
+		case "pgup":
+			*m.run.code = m.run.code.PageUp()
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.current = screenBrowse
+		m.chrome = m.chrome.SetShortcuts(ShortcutsBrowse)
+	case "up", "k":
+		m.run.up()
+	case "down", "j":
+		m.run.down()
+	case "v":
+		m.run.openCode(m.chrome.ContentWidth(), m.chrome.ContentHeight(), m.codeStyle)
+		if m.run.code != nil {
+			m.chrome = m.chrome.SetShortcuts(ShortcutsCodeView)
+		}
+	case "r":
+		if m.run != nil && m.run.done {
+			issue := m.run.issue
+			m.run = newRunScreen(issue, m.configNames())
+			return m, tea.Batch(startRun(m.runner, issue), waitForProgress(m.runner.Progress()))
+		}
+	case "enter":
+		if m.run != nil && m.run.done && m.results != nil {
+			m.current = screenResults
+			m.chrome = m.chrome.SetShortcuts(ShortcutsResults)
+		}
+	}
+	return m, nil
+}
+
+func (m model) handleResultsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		if m.results.comparing || m.results.comparison != nil {
+			m.results.comparing = false
+			m.results.comparison = nil
+		} else {
+			m.current = screenBrowse
+			m.chrome = m.chrome.SetShortcuts(ShortcutsBrowse)
+		}
+	case "up", "k":
+		m.results.up()
+	case "down", "j":
+		m.results.down()
+	case "c":
+		m.results.startCompare()
+	case "enter":
+		m.results.pick()
+	}
+	return m, nil
+}
+
+// configNames returns the names of every benchmark.Config the runner will
+// run an issue against, baseline included.
+func (m model) configNames() []string {
+	names := make([]string, 0, len(m.runner.Configs))
+	for _, cfg := range m.runner.Configs {
+		names = append(names, cfg.Name)
+	}
+	return names
+}
+
+func (m model) View() string {
+	if m.width == 0 {
+		return ""
+	}
+
+	var body string
+	switch m.current {
+	case screenRun:
+		m.chrome = m.chrome.SetContext(m.run.header())
+		if m.run.done && m.run.code == nil {
+			m.chrome = m.chrome.SetShortcuts(append(append([]Shortcut{}, ShortcutsRun...), Shortcut{Key: "Enter", Desc: "View results"}))
+		}
+		body = m.run.render(m.width, m.chrome.ContentHeight(), m.theme)
+	case screenResults:
+		m.chrome = m.chrome.SetContext("results")
+		body = m.results.render(m.width, m.chrome.ContentHeight(), m.theme)
+	default:
+		m.chrome = m.chrome.SetContext(m.browse.facetLabel())
+		body = m.browse.render(m.width, m.chrome.ContentHeight(), m.theme)
+	}
+
+	return m.chrome.Render(body)
+}
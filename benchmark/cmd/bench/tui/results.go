@@ -0,0 +1,128 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/benchmark"
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// resultsScreen shows per-config scores for the most recently finished
+// run, and - once <c> has been pressed and Enter has then picked a
+// baseline and a test config - a side-by-side benchmark.Comparison between
+// them.
+type resultsScreen struct {
+	result  *benchmark.BenchmarkResult
+	configs []string
+	cursor  int
+
+	comparing       bool
+	compareBaseline string
+	comparison      *benchmark.Comparison
+}
+
+func newResultsScreen(result *benchmark.BenchmarkResult, configs []string) *resultsScreen {
+	return &resultsScreen{result: result, configs: configs}
+}
+
+func (s *resultsScreen) up() {
+	if s.cursor > 0 {
+		s.cursor--
+	}
+}
+
+func (s *resultsScreen) down() {
+	if s.cursor < len(s.configs)-1 {
+		s.cursor++
+	}
+}
+
+// startCompare enters compare-picking mode: the first Enter press after
+// this records the baseline config, the second records the test config
+// and computes the Comparison.
+func (s *resultsScreen) startCompare() {
+	s.comparing = true
+	s.compareBaseline = ""
+	s.comparison = nil
+}
+
+// pick is called on Enter while s.comparing is true.
+func (s *resultsScreen) pick() {
+	if !s.comparing || len(s.configs) == 0 {
+		return
+	}
+	name := s.configs[s.cursor]
+
+	if s.compareBaseline == "" {
+		s.compareBaseline = name
+		return
+	}
+
+	s.comparison = s.result.Compare(s.compareBaseline, name)
+	s.comparing = false
+}
+
+func (s *resultsScreen) render(width, height int, t *theme.Theme) string {
+	if s.comparison != nil {
+		return s.renderComparison(width, height, t)
+	}
+
+	var lines []string
+	if s.comparing {
+		prompt := "pick a baseline config"
+		if s.compareBaseline != "" {
+			prompt = fmt.Sprintf("baseline=%s, pick a config to compare against it", s.compareBaseline)
+		}
+		lines = append(lines, lipgloss.NewStyle().Foreground(t.Accent).Render(prompt), "")
+	}
+
+	for i, name := range s.configs {
+		lines = append(lines, s.renderRow(name, i == s.cursor, t))
+	}
+
+	return lipgloss.NewStyle().Width(width).Height(height).Render(strings.Join(lines, "\n"))
+}
+
+func (s *resultsScreen) renderRow(name string, selected bool, t *theme.Theme) string {
+	cr := s.result.ConfigResults[name]
+	marker := "  "
+	style := lipgloss.NewStyle()
+	if selected {
+		marker = lipgloss.NewStyle().Foreground(t.Primary).Render("▸ ")
+		style = style.Bold(true)
+	}
+	if cr == nil {
+		return marker + style.Render(name) + "  " + lipgloss.NewStyle().Foreground(t.Muted).Render("no results")
+	}
+	stats := fmt.Sprintf("%d/%d passed  score %.1f%%", cr.SuccessCount, cr.TotalIssues, cr.AverageScore*100)
+	return marker + style.Width(24).Render(name) + lipgloss.NewStyle().Foreground(t.Muted).Render(stats)
+}
+
+func (s *resultsScreen) renderComparison(width, height int, t *theme.Theme) string {
+	c := s.comparison
+	var lines []string
+	lines = append(lines, lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("%s vs %s", c.TestConfig, c.BaselineConfig)))
+	lines = append(lines, "")
+	lines = append(lines, fmt.Sprintf("  baseline: %.1f%%  (95%% CI %.1f%%-%.1f%%)", c.BaselineRate*100, c.BaselineCI[0]*100, c.BaselineCI[1]*100))
+	lines = append(lines, fmt.Sprintf("  test:     %.1f%%  (95%% CI %.1f%%-%.1f%%)", c.TestRate*100, c.TestCI[0]*100, c.TestCI[1]*100))
+	lines = append(lines, "")
+
+	sign := "+"
+	color := t.Success
+	if c.Improvement < 0 {
+		sign, color = "", t.Error
+	}
+	lines = append(lines, lipgloss.NewStyle().Foreground(color).Render(
+		fmt.Sprintf("  %s%.1fpp  (delta CI %.1fpp-%.1fpp)", sign, c.Improvement, c.DeltaCI[0], c.DeltaCI[1])))
+
+	sig := "not significant"
+	if c.Significant {
+		sig = "significant"
+	}
+	lines = append(lines, fmt.Sprintf("  p=%.4f (%s)", c.PValue, sig))
+
+	return lipgloss.NewStyle().Width(width).Height(height).Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,213 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/benchmark"
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// caseState is one (config, issue) pair's progress within a runScreen.
+type caseState struct {
+	running bool
+	result  *benchmark.IssueResult
+}
+
+// runScreen streams benchmark.BenchmarkRunner.Run's progress for a single
+// issue across every configured Config, so a human tuning a config sees
+// pass/fail land per-config as the run executes instead of waiting on Run
+// to return.
+type runScreen struct {
+	issue   *benchmark.Issue
+	configs []string
+	states  map[string]*caseState
+	start   time.Time
+	done    bool
+	result  *benchmark.BenchmarkResult
+	runErr  error
+
+	cursor int
+
+	// code is the CodeView open over the cursor's ClaudeOutput, nil when
+	// no viewer is open. Opened/closed by <v>/<esc> in handleRunKey.
+	code *CodeView
+}
+
+func newRunScreen(issue *benchmark.Issue, configs []string) *runScreen {
+	states := make(map[string]*caseState, len(configs))
+	for _, name := range configs {
+		states[name] = &caseState{}
+	}
+	return &runScreen{issue: issue, configs: configs, states: states, start: time.Now()}
+}
+
+func (r *runScreen) up() {
+	if r.cursor > 0 {
+		r.cursor--
+	}
+}
+
+func (r *runScreen) down() {
+	if r.cursor < len(r.configs)-1 {
+		r.cursor++
+	}
+}
+
+// openCode opens a CodeView over the highlighted config's ClaudeOutput,
+// sized to fit, for a failing case to be read in full instead of the
+// one-line summary renderRow shows.
+func (r *runScreen) openCode(width, height int, style string) {
+	if r.cursor < 0 || r.cursor >= len(r.configs) {
+		return
+	}
+	st := r.states[r.configs[r.cursor]]
+	if st == nil || st.result == nil {
+		return
+	}
+	view := NewCodeView(st.result.ClaudeOutput, CodeViewConfig{
+		Language: r.issue.Language,
+		Style:    style,
+	}).SetSize(width, height)
+	r.code = &view
+}
+
+func (r *runScreen) closeCode() {
+	r.code = nil
+}
+
+// progressMsg wraps one benchmark.ProgressEvent read off
+// BenchmarkRunner.Progress().
+type progressMsg struct {
+	ev benchmark.ProgressEvent
+	ch <-chan benchmark.ProgressEvent
+}
+
+// runDoneMsg carries the result of the background Run call started by
+// startRun.
+type runDoneMsg struct {
+	result *benchmark.BenchmarkResult
+	err    error
+}
+
+// waitForProgress returns a tea.Cmd that blocks on ch and re-arms itself,
+// the standard Bubble Tea "listen on a channel" pattern.
+func waitForProgress(ch <-chan benchmark.ProgressEvent) tea.Cmd {
+	return func() tea.Msg {
+		ev, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return progressMsg{ev: ev, ch: ch}
+	}
+}
+
+// startRun kicks off runner.Run against a single-issue corpus in the
+// background and returns a tea.Cmd that resolves once it's done; progress
+// in the meantime arrives separately through waitForProgress.
+func startRun(runner *benchmark.BenchmarkRunner, issue *benchmark.Issue) tea.Cmd {
+	return func() tea.Msg {
+		corpus := &benchmark.Corpus{Name: "interactive", Issues: []*benchmark.Issue{issue}}
+		result, err := runner.Run(context.Background(), corpus)
+		return runDoneMsg{result: result, err: err}
+	}
+}
+
+func (r *runScreen) applyProgress(ev benchmark.ProgressEvent) {
+	st := r.states[ev.ConfigName]
+	if st == nil {
+		return
+	}
+	switch ev.State {
+	case benchmark.ProgressStarted:
+		st.running = true
+	case benchmark.ProgressDone:
+		st.running = false
+		st.result = ev.Result
+	}
+}
+
+func (r *runScreen) applyDone(msg runDoneMsg) {
+	r.done = true
+	r.result = msg.result
+	r.runErr = msg.err
+}
+
+// header is the Chrome Context string for the header bar: elapsed time and
+// a running pass/fail tally across every config that's finished so far.
+func (r *runScreen) header() string {
+	passed, failed, total := 0, 0, 0
+	for _, st := range r.states {
+		if st.result == nil {
+			continue
+		}
+		total++
+		if st.result.Success {
+			passed++
+		} else {
+			failed++
+		}
+	}
+	elapsed := time.Since(r.start).Round(time.Second)
+	return fmt.Sprintf("%s  %d/%d done  ✓%d ✗%d  %s", r.issue.ID, total, len(r.configs), passed, failed, elapsed)
+}
+
+func (r *runScreen) render(width, height int, t *theme.Theme) string {
+	if r.code != nil {
+		return r.code.SetSize(width, height).Render()
+	}
+
+	var lines []string
+	lines = append(lines, lipgloss.NewStyle().Bold(true).Render(r.issue.Title))
+	lines = append(lines, "")
+
+	for i, name := range r.configs {
+		st := r.states[name]
+		lines = append(lines, r.renderRow(name, st, i == r.cursor, t))
+	}
+
+	if r.runErr != nil {
+		lines = append(lines, "", lipgloss.NewStyle().Foreground(t.Error).Render("error: "+r.runErr.Error()))
+	}
+
+	return lipgloss.NewStyle().Width(width).Height(height).Render(strings.Join(lines, "\n"))
+}
+
+func (r *runScreen) renderRow(name string, st *caseState, selected bool, t *theme.Theme) string {
+	var icon string
+	var color lipgloss.Color
+	switch {
+	case st.result != nil && st.result.Success:
+		icon, color = t.Icons.Check, t.Success
+	case st.result != nil:
+		icon, color = t.Icons.Cross, t.Error
+	case st.running:
+		icon, color = t.Icons.Circle, t.Running
+	default:
+		icon, color = t.Icons.CircleO, t.Pending
+	}
+
+	marker := "  "
+	nameStyle := lipgloss.NewStyle()
+	if selected {
+		marker = lipgloss.NewStyle().Foreground(t.Primary).Render("▸ ")
+		nameStyle = nameStyle.Bold(true)
+	}
+	label := nameStyle.Width(20).Render(name)
+	mark := lipgloss.NewStyle().Foreground(color).Render(icon)
+
+	detail := "pending"
+	switch {
+	case st.result != nil:
+		detail = fmt.Sprintf("score %.0f%%  %s", st.result.Score*100, st.result.Duration.Round(time.Second))
+	case st.running:
+		detail = "running…"
+	}
+
+	return marker + mark + " " + label + lipgloss.NewStyle().Foreground(t.Muted).Render(detail)
+}
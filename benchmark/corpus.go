@@ -58,6 +58,7 @@ type Issue struct {
 	RepoURL     string `yaml:"repo_url"`     // GitHub URL to clone
 	RepoRef     string `yaml:"repo_ref"`     // Branch/tag/commit to checkout
 	IssueURL    string `yaml:"issue_url"`    // Optional: link to actual GitHub issue
+	VCS         string `yaml:"vcs,omitempty"` // "git", "hg", "local", or "tarball"; empty guesses from RepoURL, see vcsFor
 
 	// The actual prompt given to Claude
 	Prompt      string `yaml:"prompt"`
@@ -69,7 +70,8 @@ type Issue struct {
 	TestCommand string `yaml:"test_command,omitempty"` // e.g., "go test ./..."
 
 	// For llm_judge evaluation
-	SuccessCriteria string `yaml:"success_criteria,omitempty"` // What defines success
+	SuccessCriteria string            `yaml:"success_criteria,omitempty"` // What defines success
+	Rubric          []RubricCriterion `yaml:"rubric,omitempty"`           // Weighted criteria for structured judging
 
 	// For custom_check evaluation
 	CheckScript string `yaml:"check_script,omitempty"` // Script to run for validation
@@ -84,6 +86,14 @@ type Issue struct {
 	Tags []string `yaml:"tags,omitempty"`
 }
 
+// RubricCriterion is one weighted criterion an llm_judge evaluation should
+// check, surfaced to the judge prompt so scoring is reproducible instead of
+// left to the judge's own discretion.
+type RubricCriterion struct {
+	Criterion string  `yaml:"criterion"`
+	Weight    float64 `yaml:"weight"`
+}
+
 // Corpus is a collection of benchmark issues.
 type Corpus struct {
 	Name        string   `yaml:"name"`
@@ -3,6 +3,7 @@ package benchmark
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -13,9 +14,10 @@ import (
 
 // EvalResult contains the evaluation outcome.
 type EvalResult struct {
-	Success bool    `json:"success"`
-	Score   float64 `json:"score"`   // 0.0 - 1.0
-	Details string  `json:"details"` // Explanation
+	Success bool        `json:"success"`
+	Score   float64     `json:"score"`            // 0.0 - 1.0
+	Details string      `json:"details"`          // Explanation
+	Report  *TestReport `json:"report,omitempty"` // structured test results, when available
 }
 
 // evaluate determines if Claude successfully solved the issue.
@@ -41,14 +43,16 @@ func (r *BenchmarkRunner) evalTestSuite(ctx context.Context, issue *Issue, repoD
 
 	testCmd := issue.TestCommand
 	if testCmd == "" {
-		// Default test commands by language
+		// Default test commands by language. Go and JS/TS default to
+		// machine-readable output so parseTestReport can build a structured
+		// TestReport instead of falling back to the parseTestScore heuristic.
 		switch issue.Language {
 		case "go":
-			testCmd = "go test ./..."
+			testCmd = "go test -json ./..."
 		case "typescript", "javascript":
-			testCmd = "npm test"
+			testCmd = "npx jest --json"
 		case "python":
-			testCmd = "pytest"
+			testCmd = "pytest --junitxml=.benchmark_junit.xml"
 		case "rust":
 			testCmd = "cargo test"
 		default:
@@ -66,23 +70,28 @@ func (r *BenchmarkRunner) evalTestSuite(ctx context.Context, issue *Issue, repoD
 		}
 	}
 
-	cmd := exec.CommandContext(ctx, parts[0], parts[1:]...)
-	cmd.Dir = repoDir
+	stdout, stderr, err := r.Sandbox.Run(ctx, issue.Language, repoDir, parts)
+	combinedOutput := string(stdout) + "\n" + string(stderr)
 
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	report := parseTestReport(issue.Language, stdout)
+	if report == nil && issue.Language == "python" {
+		report = readJUnitReport(repoDir)
+	}
 
-	err := cmd.Run()
-	combinedOutput := stdout.String() + "\n" + stderr.String()
+	score := 1.0
+	if report != nil {
+		score = report.Score()
+	} else if err != nil {
+		// No structured report available; fall back to the substring heuristic.
+		score = parseTestScore(combinedOutput, issue.Language)
+	}
 
 	if err != nil {
-		// Check if it's a partial pass (some tests passed)
-		score := parseTestScore(combinedOutput, issue.Language)
 		return EvalResult{
 			Success: false,
 			Score:   score,
 			Details: fmt.Sprintf("Tests failed: %v\n%s", err, truncateOutput(combinedOutput, 500)),
+			Report:  report,
 		}
 	}
 
@@ -90,9 +99,28 @@ func (r *BenchmarkRunner) evalTestSuite(ctx context.Context, issue *Issue, repoD
 		Success: true,
 		Score:   1.0,
 		Details: "All tests passed",
+		Report:  report,
 	}
 }
 
+// readJUnitReport reads and removes the JUnit XML file pytest --junitxml
+// wrote into repoDir, returning nil if it's missing or unparsable.
+func readJUnitReport(repoDir string) *TestReport {
+	path := filepath.Join(repoDir, ".benchmark_junit.xml")
+	defer os.Remove(path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	report, err := parseJUnitXML(data)
+	if err != nil {
+		return nil
+	}
+	return report
+}
+
 // evalLLMJudge uses Claude to evaluate the solution.
 func (r *BenchmarkRunner) evalLLMJudge(ctx context.Context, issue *Issue, repoDir, output string) EvalResult {
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
@@ -115,6 +143,9 @@ func (r *BenchmarkRunner) evalLLMJudge(ctx context.Context, issue *Issue, repoDi
 ## Success Criteria
 %s
 
+## Rubric
+%s
+
 ## Changes Made (git diff)
 %s
 
@@ -125,12 +156,11 @@ func (r *BenchmarkRunner) evalLLMJudge(ctx context.Context, issue *Issue, repoDi
 1. Did the assistant make appropriate code changes?
 2. Do the changes address the problem described?
 3. Are there any obvious bugs or issues in the solution?
+4. Score each rubric criterion independently before giving an overall score.
 
-Rate the solution on a scale of 0-100 and explain your reasoning.
-Format your response as:
-SCORE: [number]
-REASON: [explanation]
-`, issue.Description, criteria, truncateOutput(diffOutput, 3000), truncateOutput(output, 2000))
+Respond with a single JSON object and nothing else, matching this shape:
+{"score": <0-100>, "verdict": "pass|fail|partial", "rubric": [{"criterion": "...", "met": true|false, "weight": <float>, "notes": "..."}], "reason": "..."}
+`, issue.Description, criteria, renderRubric(issue.Rubric), truncateOutput(diffOutput, 3000), truncateOutput(output, 2000))
 
 	cmd := exec.CommandContext(ctx, r.ClaudeBinary, "--print", judgePrompt)
 
@@ -147,15 +177,28 @@ REASON: [explanation]
 	}
 
 	response := stdout.String()
-	score, reason := parseLLMJudgeResponse(response)
+	score, success, reason := parseLLMJudgeResponse(response, issue.Rubric)
 
 	return EvalResult{
-		Success: score >= 0.7,
+		Success: success,
 		Score:   score,
 		Details: reason,
 	}
 }
 
+// renderRubric formats an Issue's rubric for inclusion in the judge prompt.
+func renderRubric(rubric []RubricCriterion) string {
+	if len(rubric) == 0 {
+		return "(none declared - judge the solution holistically)"
+	}
+
+	var b strings.Builder
+	for _, c := range rubric {
+		fmt.Fprintf(&b, "- %s (weight %.1f)\n", c.Criterion, c.Weight)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
 // evalCustomCheck runs a custom validation script.
 func (r *BenchmarkRunner) evalCustomCheck(ctx context.Context, issue *Issue, repoDir string) EvalResult {
 	if issue.CheckScript == "" {
@@ -169,8 +212,10 @@ func (r *BenchmarkRunner) evalCustomCheck(ctx context.Context, issue *Issue, rep
 	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
 	defer cancel()
 
-	// Write script to temp file
-	scriptPath := filepath.Join(repoDir, ".benchmark_check.sh")
+	// Write script into the repo (relative name, so it resolves whether the
+	// sandbox runs on the host or a container with repoDir bind-mounted).
+	const scriptName = ".benchmark_check.sh"
+	scriptPath := filepath.Join(repoDir, scriptName)
 	if err := os.WriteFile(scriptPath, []byte(issue.CheckScript), 0755); err != nil {
 		return EvalResult{
 			Success: false,
@@ -180,26 +225,19 @@ func (r *BenchmarkRunner) evalCustomCheck(ctx context.Context, issue *Issue, rep
 	}
 	defer os.Remove(scriptPath)
 
-	cmd := exec.CommandContext(ctx, "bash", scriptPath)
-	cmd.Dir = repoDir
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	err := cmd.Run()
+	stdout, stderr, err := r.Sandbox.Run(ctx, issue.Language, repoDir, []string{"bash", scriptName})
 	if err != nil {
 		return EvalResult{
 			Success: false,
 			Score:   0.0,
-			Details: fmt.Sprintf("Check failed: %v\n%s", err, stderr.String()),
+			Details: fmt.Sprintf("Check failed: %v\n%s", err, stderr),
 		}
 	}
 
 	return EvalResult{
 		Success: true,
 		Score:   1.0,
-		Details: stdout.String(),
+		Details: string(stdout),
 	}
 }
 
@@ -217,6 +255,7 @@ func (r *BenchmarkRunner) evalHybrid(ctx context.Context, issue *Issue, repoDir,
 		Details: fmt.Sprintf("Test score: %.0f%%, LLM score: %.0f%%\nTests: %s\nLLM: %s",
 			testResult.Score*100, llmResult.Score*100,
 			testResult.Details, llmResult.Details),
+		Report: testResult.Report,
 	}
 }
 
@@ -278,8 +317,126 @@ func parseTestScore(output, language string) float64 {
 	return 0.0
 }
 
-// parseLLMJudgeResponse extracts score and reason from LLM response.
-func parseLLMJudgeResponse(response string) (float64, string) {
+// judgeCriterionResult is one scored criterion in a judgeResponse.
+type judgeCriterionResult struct {
+	Criterion string  `json:"criterion"`
+	Met       bool    `json:"met"`
+	Weight    float64 `json:"weight"`
+	Notes     string  `json:"notes"`
+}
+
+// judgeResponse is the structured protocol the judge prompt asks Claude to
+// return: a single JSON object rather than SCORE:/REASON: lines, so parsing
+// is deterministic and per-criterion results are auditable.
+type judgeResponse struct {
+	Score   float64                `json:"score"`   // 0-100
+	Verdict string                 `json:"verdict"` // "pass", "fail", or "partial"
+	Rubric  []judgeCriterionResult `json:"rubric"`
+	Reason  string                 `json:"reason"`
+}
+
+// parseLLMJudgeResponse extracts a score, success verdict, and details from
+// an LLM judge response. It expects the structured JSON protocol described
+// in the judge prompt, falling back to the legacy SCORE:/REASON: scraping
+// for judges (or older prompts) that don't follow it.
+func parseLLMJudgeResponse(response string, rubric []RubricCriterion) (score float64, success bool, details string) {
+	jr, err := parseJudgeJSON(response)
+	if err != nil {
+		score, details = parseLLMJudgeResponseLegacy(response)
+		return score, score >= 0.7, details
+	}
+
+	score = jr.Score / 100.0
+	if len(jr.Rubric) > 0 {
+		score = weightedRubricScore(jr.Rubric)
+	}
+
+	switch jr.Verdict {
+	case "pass":
+		success = true
+	case "fail":
+		success = false
+	default:
+		success = score >= 0.7
+	}
+
+	return score, success, formatJudgeDetails(jr)
+}
+
+// parseJudgeJSON extracts and validates the structured judge response
+// object from response, tolerating surrounding prose by taking the
+// substring between the first '{' and the last '}'.
+func parseJudgeJSON(response string) (*judgeResponse, error) {
+	start := strings.Index(response, "{")
+	end := strings.LastIndex(response, "}")
+	if start < 0 || end <= start {
+		return nil, fmt.Errorf("no JSON object found in judge response")
+	}
+
+	var jr judgeResponse
+	if err := json.Unmarshal([]byte(response[start:end+1]), &jr); err != nil {
+		return nil, fmt.Errorf("decode judge response: %w", err)
+	}
+
+	switch jr.Verdict {
+	case "pass", "fail", "partial", "":
+	default:
+		return nil, fmt.Errorf("invalid verdict %q", jr.Verdict)
+	}
+
+	return &jr, nil
+}
+
+// weightedRubricScore computes the fraction of rubric weight satisfied.
+// Criteria with a zero or negative weight count as weight 1.
+func weightedRubricScore(rubric []judgeCriterionResult) float64 {
+	var total, met float64
+	for _, c := range rubric {
+		weight := c.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		total += weight
+		if c.Met {
+			met += weight
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return met / total
+}
+
+// formatJudgeDetails renders the per-criterion rubric breakdown (when
+// present) followed by the judge's overall reasoning, for EvalResult.Details.
+func formatJudgeDetails(jr *judgeResponse) string {
+	if len(jr.Rubric) == 0 {
+		return jr.Reason
+	}
+
+	var b strings.Builder
+	for _, c := range jr.Rubric {
+		status := "✗"
+		if c.Met {
+			status = "✓"
+		}
+		fmt.Fprintf(&b, "%s %s (weight %.1f)", status, c.Criterion, c.Weight)
+		if c.Notes != "" {
+			fmt.Fprintf(&b, " - %s", c.Notes)
+		}
+		b.WriteString("\n")
+	}
+
+	if jr.Reason != "" {
+		b.WriteString("\n" + jr.Reason)
+	}
+	return b.String()
+}
+
+// parseLLMJudgeResponseLegacy extracts score and reason from a judge
+// response that used the old SCORE:/REASON: line-prefix convention instead
+// of the JSON protocol.
+func parseLLMJudgeResponseLegacy(response string) (float64, string) {
 	lines := strings.Split(response, "\n")
 
 	var score float64 = 0.0
@@ -322,6 +479,60 @@ func parseLLMJudgeResponse(response string) (float64, string) {
 	return score, reason
 }
 
+// modifiedRepoFiles returns every repo-relative path Claude's session
+// touched in repoDir: files git diff sees as changed against HEAD, plus new
+// untracked files. It's the input to jaccardScore's partial-credit check,
+// so a run that edited roughly the right files still earns something even
+// when the eval strategy's pass/fail check comes back negative.
+func modifiedRepoFiles(ctx context.Context, repoDir string) ([]string, error) {
+	var files []string
+
+	diffOut, err := exec.CommandContext(ctx, "git", "-C", repoDir, "diff", "--name-only", "HEAD").Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff: %w", err)
+	}
+	files = append(files, strings.Fields(string(diffOut))...)
+
+	untrackedOut, err := exec.CommandContext(ctx, "git", "-C", repoDir, "ls-files", "--others", "--exclude-standard").Output()
+	if err == nil {
+		files = append(files, strings.Fields(string(untrackedOut))...)
+	}
+
+	return files, nil
+}
+
+// jaccardScore is the Jaccard similarity (|intersection| / |union|) between
+// two path sets, used to award partial credit when the files Claude
+// actually touched overlap with an issue's ExpectedFiles but don't match
+// exactly. Two empty sets score 0, not NaN - there's nothing to overlap.
+func jaccardScore(got, want []string) float64 {
+	gotSet := make(map[string]bool, len(got))
+	for _, f := range got {
+		gotSet[f] = true
+	}
+	wantSet := make(map[string]bool, len(want))
+	for _, f := range want {
+		wantSet[f] = true
+	}
+
+	union := make(map[string]bool, len(gotSet)+len(wantSet))
+	intersection := 0
+	for f := range gotSet {
+		union[f] = true
+		if wantSet[f] {
+			intersection++
+		}
+	}
+	for f := range wantSet {
+		union[f] = true
+	}
+
+	if len(union) == 0 {
+		return 0
+	}
+	return float64(intersection) / float64(len(union))
+}
+
 // truncateOutput limits output length.
 func truncateOutput(s string, maxLen int) string {
 	if len(s) <= maxLen {
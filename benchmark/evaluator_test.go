@@ -0,0 +1,31 @@
+package benchmark
+
+import "testing"
+
+// TestJaccardScore checks jaccardScore's similarity math and its two
+// explicitly-documented edge cases: empty sets score 0 (not NaN), and
+// duplicate entries within a set don't inflate the union/intersection.
+func TestJaccardScore(t *testing.T) {
+	tests := []struct {
+		name      string
+		got, want []string
+		wantScore float64
+	}{
+		{"both empty", nil, nil, 0},
+		{"no overlap", []string{"a.go"}, []string{"b.go"}, 0},
+		{"exact match", []string{"a.go", "b.go"}, []string{"a.go", "b.go"}, 1},
+		// intersection {b.go}, union {a.go, b.go, c.go} -> 1/3.
+		{"partial overlap", []string{"a.go", "b.go"}, []string{"b.go", "c.go"}, 1.0 / 3.0},
+		{"duplicates don't inflate the set", []string{"a.go", "a.go"}, []string{"a.go"}, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := jaccardScore(tc.got, tc.want)
+			const epsilon = 1e-9
+			if diff := got - tc.wantScore; diff < -epsilon || diff > epsilon {
+				t.Errorf("jaccardScore(%v, %v) = %v, want %v", tc.got, tc.want, got, tc.wantScore)
+			}
+		})
+	}
+}
@@ -0,0 +1,382 @@
+// Package htmlreport renders a benchmark.BenchmarkResult as a static,
+// multi-page site: an index with a configs-by-issues outcome matrix, and one
+// drilldown page per issue showing every config's highlighted response side
+// by side. Unlike benchmark.RenderHTML's single self-contained page, this is
+// meant for results with larger corpora where the per-issue detail doesn't
+// fit in one table row.
+package htmlreport
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/alecthomas/chroma/v2"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+
+	"github.com/MateoSegura/.claude/benchmark"
+)
+
+//go:embed assets/style.css assets/compare.js
+var assetsFS embed.FS
+
+// DefaultLightStyle and DefaultDarkStyle are the Chroma styles used to
+// generate chroma.css's light (unprefixed) and dark ([data-theme="dark"]
+// scoped) rules. Chosen for contrast against style.css's light/dark
+// backgrounds rather than for matching CodeView's terminal-oriented default.
+const (
+	DefaultLightStyle = "github"
+	DefaultDarkStyle  = "monokai"
+)
+
+// HTMLReport renders Result as a static site under a directory.
+type HTMLReport struct {
+	Result *benchmark.BenchmarkResult
+
+	// LightStyle and DarkStyle are Chroma style names. Empty means
+	// DefaultLightStyle / DefaultDarkStyle.
+	LightStyle string
+	DarkStyle  string
+}
+
+// New creates an HTMLReport over result with the default Chroma styles.
+func New(result *benchmark.BenchmarkResult) *HTMLReport {
+	return &HTMLReport{Result: result}
+}
+
+// Write renders the site into dir, creating it (and an issues/
+// subdirectory) if needed. Files are overwritten on repeated calls, so
+// Write can be re-run after a fresh benchmark run without cleaning dir
+// first.
+func (r *HTMLReport) Write(dir string) error {
+	if err := os.MkdirAll(filepath.Join(dir, "issues"), 0755); err != nil {
+		return fmt.Errorf("create output dir: %w", err)
+	}
+
+	if err := r.writeAssets(dir); err != nil {
+		return err
+	}
+
+	configNames := r.Result.SortedConfigNames()
+	issueIDs := r.canonicalIssueOrder(configNames)
+
+	if err := r.writeIndex(dir, configNames, issueIDs); err != nil {
+		return err
+	}
+
+	for _, id := range issueIDs {
+		if err := r.writeIssuePage(dir, configNames, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeAssets copies the embedded CSS/JS straight through and generates
+// chroma.css from LightStyle/DarkStyle.
+func (r *HTMLReport) writeAssets(dir string) error {
+	if err := copyEmbedded(assetsFS, "assets/style.css", filepath.Join(dir, "style.css")); err != nil {
+		return err
+	}
+	if err := copyEmbedded(assetsFS, "assets/compare.js", filepath.Join(dir, "compare.js")); err != nil {
+		return err
+	}
+
+	css, err := r.chromaCSS()
+	if err != nil {
+		return fmt.Errorf("generate chroma.css: %w", err)
+	}
+	return os.WriteFile(filepath.Join(dir, "chroma.css"), []byte(css), 0644)
+}
+
+func copyEmbedded(assets embed.FS, name, dest string) error {
+	data, err := assets.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dest, data, 0644)
+}
+
+// darkRulePrefix matches a CSS selector at the start of a rule (not inside a
+// comment or at-rule) so scopeDark can prefix it with the dark-theme
+// attribute selector.
+var darkRulePrefix = regexp.MustCompile(`(?m)^([^@{}\s][^{]*)\{`)
+
+// chromaCSS renders LightStyle's rules unprefixed (the default, light-mode
+// appearance) followed by DarkStyle's rules scoped under
+// [data-theme="dark"], mirroring style.css's own light/dark convention.
+func (r *HTMLReport) chromaCSS() (string, error) {
+	light := r.LightStyle
+	if light == "" {
+		light = DefaultLightStyle
+	}
+	dark := r.DarkStyle
+	if dark == "" {
+		dark = DefaultDarkStyle
+	}
+
+	var buf strings.Builder
+	if err := writeStyleCSS(&buf, light); err != nil {
+		return "", err
+	}
+
+	var darkBuf strings.Builder
+	if err := writeStyleCSS(&darkBuf, dark); err != nil {
+		return "", err
+	}
+	buf.WriteString(scopeDark(darkBuf.String()))
+
+	return buf.String(), nil
+}
+
+// writeStyleCSS looks up a Chroma style by name and writes its class-based
+// CSS (chromahtml.ClassPrefix matches the formatter built in highlightHTML).
+func writeStyleCSS(w io.Writer, name string) error {
+	style := styles.Get(name)
+	if style == nil {
+		style = styles.Fallback
+	}
+	formatter := chromahtml.New(chromahtml.WithClasses(true), chromahtml.ClassPrefix("chroma-"))
+	return formatter.WriteCSS(w, style)
+}
+
+// scopeDark prefixes every selector in css with [data-theme="dark"] so it
+// only applies once the theme toggle switches the page into dark mode.
+func scopeDark(css string) string {
+	return darkRulePrefix.ReplaceAllString(css, `[data-theme="dark"] $1{`)
+}
+
+// canonicalIssueOrder returns every issue ID in the order they first appear
+// across configNames' results, so the index and issue pages agree on
+// ordering even though ConfigResult.IssueResults for different configs may
+// not all be in the same order.
+func (r *HTMLReport) canonicalIssueOrder(configNames []string) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, name := range configNames {
+		cr := r.Result.ConfigResults[name]
+		for _, ir := range cr.IssueResults {
+			if !seen[ir.IssueID] {
+				seen[ir.IssueID] = true
+				ids = append(ids, ir.IssueID)
+			}
+		}
+	}
+	return ids
+}
+
+// resultFor returns configName's IssueResult for issueID, or nil if that
+// config didn't run it.
+func (r *HTMLReport) resultFor(configName, issueID string) *benchmark.IssueResult {
+	cr := r.Result.ConfigResults[configName]
+	if cr == nil {
+		return nil
+	}
+	for _, ir := range cr.IssueResults {
+		if ir.IssueID == issueID {
+			return ir
+		}
+	}
+	return nil
+}
+
+// indexData is the view model fed to indexTemplate.
+type indexData struct {
+	CorpusName    string
+	CorpusVersion string
+	Timestamp     string
+	Duration      string
+	Configs       []string
+	Rows          []indexRow
+}
+
+// indexRow is one issue's row in the matrix table.
+type indexRow struct {
+	IssueID string
+	Href    string
+	Cells   []indexCell
+}
+
+// indexCell is one (config, issue) outcome cell.
+type indexCell struct {
+	ConfigName string
+	Outcome    string // "pass", "fail", or "" if the config didn't run this issue
+	Label      string
+	Href       string
+}
+
+func (r *HTMLReport) writeIndex(dir string, configNames, issueIDs []string) error {
+	data := indexData{
+		CorpusName:    r.Result.CorpusName,
+		CorpusVersion: r.Result.CorpusVersion,
+		Timestamp:     r.Result.Timestamp.Format("2006-01-02 15:04"),
+		Duration:      r.Result.Duration.Round(time.Second).String(),
+		Configs:       configNames,
+	}
+
+	for _, id := range issueIDs {
+		row := indexRow{IssueID: id, Href: filepath.Join("issues", id+".html")}
+		for _, name := range configNames {
+			cell := indexCell{ConfigName: name, Href: filepath.Join("issues", id+".html")}
+			if ir := r.resultFor(name, id); ir != nil {
+				if ir.Success {
+					cell.Outcome, cell.Label = "pass", "✓"
+				} else {
+					cell.Outcome, cell.Label = "fail", "✗"
+				}
+			}
+			row.Cells = append(row.Cells, cell)
+		}
+		data.Rows = append(data.Rows, row)
+	}
+
+	f, err := os.Create(filepath.Join(dir, "index.html"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return indexTemplate.Execute(f, data)
+}
+
+var indexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Benchmark Report: {{.CorpusName}}</title>
+<link rel="stylesheet" href="style.css">
+<link rel="stylesheet" href="chroma.css">
+<script src="compare.js" defer></script>
+</head>
+<body>
+<button class="theme-toggle">Toggle theme</button>
+<h1>Benchmark Report: {{.CorpusName}}</h1>
+<p class="meta">Version: {{.CorpusVersion}} | Run: {{.Timestamp}} | Duration: {{.Duration}}</p>
+
+<h2>Results</h2>
+<table class="matrix">
+<thead><tr><th>Issue</th>{{range .Configs}}<th>{{.}}</th>{{end}}</tr></thead>
+<tbody>
+{{range .Rows}}<tr><td><a href="{{.Href}}">{{.IssueID}}</a></td>{{range .Cells}}<td class="outcome-{{.Outcome}}" data-outcome="{{.Outcome}}"><a href="{{.Href}}">{{.Label}}</a></td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+</body>
+</html>
+`))
+
+// issuePageData is the view model fed to issueTemplate.
+type issuePageData struct {
+	IssueID string
+	Configs []issueConfigView
+}
+
+// issueConfigView is one config's response block on an issue's drilldown
+// page.
+type issueConfigView struct {
+	ConfigName  string
+	Outcome     string
+	Score       float64
+	Duration    string
+	Error       string
+	EvalDetails string
+	OutputHTML  template.HTML
+}
+
+func (r *HTMLReport) writeIssuePage(dir string, configNames []string, issueID string) error {
+	data := issuePageData{IssueID: issueID}
+
+	for _, name := range configNames {
+		ir := r.resultFor(name, issueID)
+		if ir == nil {
+			continue
+		}
+
+		outcome := "fail"
+		if ir.Success {
+			outcome = "pass"
+		}
+
+		outputHTML, err := highlightHTML(ir.ClaudeOutput, ir.Language)
+		if err != nil {
+			return fmt.Errorf("highlight %s/%s: %w", name, issueID, err)
+		}
+
+		data.Configs = append(data.Configs, issueConfigView{
+			ConfigName:  name,
+			Outcome:     outcome,
+			Score:       ir.Score * 100,
+			Duration:    ir.Duration.Round(time.Second).String(),
+			Error:       ir.Error,
+			EvalDetails: ir.EvalDetails,
+			OutputHTML:  outputHTML,
+		})
+	}
+
+	f, err := os.Create(filepath.Join(dir, "issues", issueID+".html"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return issueTemplate.Execute(f, data)
+}
+
+var issueTemplate = template.Must(template.New("issue").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.IssueID}}</title>
+<link rel="stylesheet" href="../style.css">
+<link rel="stylesheet" href="../chroma.css">
+<script src="../compare.js" defer></script>
+</head>
+<body>
+<button class="theme-toggle">Toggle theme</button>
+<p><a href="../index.html">&larr; Back to results</a></p>
+<h1>{{.IssueID}}</h1>
+
+{{range .Configs}}
+<div class="response-block">
+<h3 class="outcome-{{.Outcome}}">{{.ConfigName}}</h3>
+<p class="meta">score {{printf "%.0f" .Score}}% | {{.Duration}}{{if .Error}} | error: {{.Error}}{{end}}</p>
+{{if .EvalDetails}}<p class="meta">{{.EvalDetails}}</p>{{end}}
+{{.OutputHTML}}
+</div>
+{{end}}
+</body>
+</html>
+`))
+
+// highlightHTML renders source as class-based syntax-highlighted HTML (the
+// CSS comes from chroma.css, generated by chromaCSS) so the page can
+// light/dark-toggle without re-rendering the markup.
+func highlightHTML(source, language string) (template.HTML, error) {
+	lexer := lexers.Get(language)
+	if lexer == nil {
+		lexer = lexers.Analyse(source)
+	}
+	if lexer == nil {
+		lexer = lexers.Fallback
+	}
+	lexer = chroma.Coalesce(lexer)
+
+	iterator, err := lexer.Tokenise(nil, source)
+	if err != nil {
+		return "", err
+	}
+
+	formatter := chromahtml.New(chromahtml.WithClasses(true), chromahtml.ClassPrefix("chroma-"), chromahtml.Standalone(false))
+
+	var buf strings.Builder
+	if err := formatter.Format(&buf, styles.Fallback, iterator); err != nil {
+		return "", err
+	}
+	return template.HTML(buf.String()), nil
+}
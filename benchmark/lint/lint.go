@@ -0,0 +1,118 @@
+// Package lint statically validates a .claude directory before it's handed
+// to BenchmarkRunner: malformed command/agent frontmatter, a skill missing
+// its SKILL.md, a broken hook matcher, or a command pointing at a skill
+// that doesn't exist are all bugs in the config under test, not in the
+// issue it's being benchmarked against. Catching them here means a typo
+// fails in milliseconds instead of after a full, budget-consuming corpus
+// run comes back looking (wrongly) like a regression.
+//
+// The design mirrors skill-tests/analysis: a Rule inspects a Pass and
+// reports Diagnostics, Lint collects them from DefaultRules (or a caller's
+// own subset), and severities (SeverityInfo/Warning/Error) decide what's
+// merely surfaced versus what should fail a build.
+package lint
+
+import "fmt"
+
+// Severity classifies a Diagnostic's importance. FailsThreshold treats
+// severities as ordered (Info < Warning < Error), so "--fail-on=warning"
+// fails on Warning and Error alike.
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+)
+
+// String implements fmt.Stringer.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// ParseSeverity parses "error", "warning", or "info", the values --fail-on
+// accepts.
+func ParseSeverity(s string) (Severity, error) {
+	switch s {
+	case "error":
+		return SeverityError, nil
+	case "warning":
+		return SeverityWarning, nil
+	case "info":
+		return SeverityInfo, nil
+	default:
+		return 0, fmt.Errorf("unknown severity %q (want error, warning, or info)", s)
+	}
+}
+
+// Diagnostic is one finding from a Rule. Path is relative to the .claude
+// directory Lint ran against (e.g. "commands/deploy.md" or
+// "settings.json"), so callers can render it as a SARIF artifact location
+// or a "path: message" table row without knowing the config's absolute
+// location.
+type Diagnostic struct {
+	Rule     string
+	Severity Severity
+	Path     string
+	Message  string
+}
+
+// Rule is one static check, modeled on skill-tests/analysis.Analyzer. Run
+// inspects Pass and reports findings both via its return value and via
+// Pass.Report, so callers can use whichever is convenient: Lint only
+// collects via Report, but a Rule's Run is independently testable through
+// its return value.
+type Rule struct {
+	Name string
+	Doc  string
+	Run  func(*Pass) ([]Diagnostic, error)
+}
+
+// Lint loads configDir (a .claude directory) into a Pass and runs each rule
+// against it (DefaultRules if none given), returning every diagnostic
+// reported.
+func Lint(configDir string, rules ...*Rule) ([]Diagnostic, error) {
+	if len(rules) == 0 {
+		rules = DefaultRules
+	}
+
+	pass, err := loadPass(configDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var diags []Diagnostic
+	pass.Report = func(d Diagnostic) { diags = append(diags, d) }
+
+	for _, r := range rules {
+		if _, err := r.Run(pass); err != nil {
+			return diags, fmt.Errorf("%s: %w", r.Name, err)
+		}
+	}
+
+	for i := range diags {
+		if diags[i].Rule == "" {
+			diags[i].Rule = "unknown"
+		}
+	}
+
+	return diags, nil
+}
+
+// FailsThreshold reports whether any diagnostic meets or exceeds threshold,
+// the check behind --fail-on.
+func FailsThreshold(diags []Diagnostic, threshold Severity) bool {
+	for _, d := range diags {
+		if d.Severity >= threshold {
+			return true
+		}
+	}
+	return false
+}
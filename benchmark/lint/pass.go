@@ -0,0 +1,207 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Extension is one command or agent markdown file: its parsed frontmatter
+// plus the body a DeadSkillReference-style rule scans for cross-extension
+// links.
+type Extension struct {
+	Name           string
+	Path           string // relative to the .claude dir, e.g. "commands/deploy.md"
+	HasFrontmatter bool
+	Frontmatter    map[string]interface{}
+	Body           string
+}
+
+// Skill is one directory under skills/.
+type Skill struct {
+	Name       string
+	Path       string // relative to the .claude dir, e.g. "skills/deploy-app"
+	HasSkillMD bool
+}
+
+// HookCommand is one entry of a HookEntry's "hooks" array - the command a
+// matched tool use runs.
+type HookCommand struct {
+	Type    string `json:"type"`
+	Command string `json:"command"`
+}
+
+// HookEntry is one matcher block under a settings.json hooks event, e.g.
+// the "PostToolUse" array.
+type HookEntry struct {
+	Matcher string        `json:"matcher"`
+	Hooks   []HookCommand `json:"hooks"`
+}
+
+// SettingsFile is the subset of .claude/settings.json the hook rules care
+// about.
+type SettingsFile struct {
+	Hooks map[string][]HookEntry `json:"hooks"`
+}
+
+// Pass carries one .claude directory's parsed state to every Rule.
+type Pass struct {
+	ConfigDir string
+
+	Commands []Extension
+	Agents   []Extension
+	Skills   []Skill
+
+	HasSettings   bool // false when settings.json is missing
+	Settings      SettingsFile
+	SettingsError error // non-nil when settings.json exists but isn't valid JSON
+
+	// Report records a Diagnostic. Rules should call it for every finding;
+	// Lint supplies the implementation.
+	Report func(Diagnostic)
+}
+
+// knownEvents are the settings.json hook events HookSchemaInvalid validates.
+var knownEvents = []string{"PreToolUse", "PostToolUse"}
+
+func loadPass(configDir string) (*Pass, error) {
+	pass := &Pass{ConfigDir: configDir}
+
+	var err error
+	pass.Commands, err = loadExtensions(configDir, "commands")
+	if err != nil {
+		return nil, err
+	}
+	pass.Agents, err = loadExtensions(configDir, "agents")
+	if err != nil {
+		return nil, err
+	}
+	pass.Skills, err = loadSkills(configDir)
+	if err != nil {
+		return nil, err
+	}
+
+	data, readErr := os.ReadFile(filepath.Join(configDir, "settings.json"))
+	switch {
+	case os.IsNotExist(readErr):
+		// No settings.json is normal; HookSchemaInvalid just has nothing to check.
+	case readErr != nil:
+		return nil, fmt.Errorf("read settings.json: %w", readErr)
+	default:
+		pass.HasSettings = true
+		if err := json.Unmarshal(data, &pass.Settings); err != nil {
+			pass.SettingsError = err
+		}
+	}
+
+	return pass, nil
+}
+
+// loadExtensions reads every *.md file directly under configDir/kind
+// (kind is "commands" or "agents"), parsing each one's frontmatter.
+func loadExtensions(configDir, kind string) ([]Extension, error) {
+	dir := filepath.Join(configDir, kind)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".md") {
+			continue
+		}
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+
+	exts := make([]Extension, 0, len(names))
+	for _, name := range names {
+		rel := filepath.Join(kind, name)
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+
+		ext := Extension{
+			Name: strings.TrimSuffix(name, ".md"),
+			Path: filepath.ToSlash(rel),
+		}
+		header, body, ok := splitFrontmatter(string(data))
+		ext.Body = body
+		if ok {
+			var fm map[string]interface{}
+			if err := yaml.Unmarshal([]byte(header), &fm); err == nil {
+				ext.HasFrontmatter = true
+				ext.Frontmatter = fm
+			}
+		}
+		exts = append(exts, ext)
+	}
+	return exts, nil
+}
+
+// loadSkills reads every directory under configDir/skills, recording
+// whether it has a SKILL.md.
+func loadSkills(configDir string) ([]Skill, error) {
+	dir := filepath.Join(configDir, "skills")
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+
+	skills := make([]Skill, 0, len(names))
+	for _, name := range names {
+		_, err := os.Stat(filepath.Join(dir, name, "SKILL.md"))
+		skills = append(skills, Skill{
+			Name:       name,
+			Path:       filepath.ToSlash(filepath.Join("skills", name)),
+			HasSkillMD: err == nil,
+		})
+	}
+	return skills, nil
+}
+
+// splitFrontmatter separates a "---\n<yaml>\n---\n<body>" document into its
+// YAML header and body, the same convention skill-tests/analysis parses
+// SKILL.md with. ok is false when content has no frontmatter block, in
+// which case body is the entire content unchanged.
+func splitFrontmatter(content string) (header, body string, ok bool) {
+	const delim = "---"
+
+	trimmed := strings.TrimPrefix(content, delim)
+	if len(trimmed) == len(content) {
+		return "", content, false
+	}
+	trimmed = strings.TrimPrefix(strings.TrimPrefix(trimmed, "\r\n"), "\n")
+
+	idx := strings.Index(trimmed, "\n"+delim)
+	if idx < 0 {
+		return "", content, false
+	}
+
+	header = trimmed[:idx]
+	body = trimmed[idx+1+len(delim):]
+	body = strings.TrimPrefix(strings.TrimPrefix(body, "\r\n"), "\n")
+	return header, body, true
+}
@@ -0,0 +1,197 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// sarifLog is the root of a SARIF 2.1.0 log, trimmed to the fields this
+// package populates - the same shape skill-tests/report.go's SaveSARIF
+// builds for TestResult validations, here populated from lint Diagnostics
+// instead.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"` // "error", "warning", or "note"
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// sarifLevel maps a Severity to the level name SARIF consumers (GitHub code
+// scanning, GitLab) group findings by.
+func sarifLevel(s Severity) string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// SARIF renders diags as a SARIF 2.1.0 log: one rule per distinct
+// Diagnostic.Rule and one result per diagnostic, so a CI run can surface
+// findings in GitHub Actions/GitLab/Jenkins code-scanning UIs instead of
+// requiring ad-hoc parsing of a plain-text table.
+func SARIF(diags []Diagnostic) ([]byte, error) {
+	rules := []sarifRule{}
+	seen := make(map[string]bool)
+	var results []sarifResult
+
+	for _, d := range diags {
+		if !seen[d.Rule] {
+			seen[d.Rule] = true
+			rules = append(rules, sarifRule{ID: d.Rule, Name: d.Rule})
+		}
+		results = append(results, sarifResult{
+			RuleID:  d.Rule,
+			Level:   sarifLevel(d.Severity),
+			Message: sarifMessage{Text: d.Message},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: d.Path},
+				},
+			}},
+		})
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{
+			{
+				Tool:    sarifTool{Driver: sarifDriver{Name: "bench lint", Rules: rules}},
+				Results: results,
+			},
+		},
+	}
+
+	return json.MarshalIndent(log, "", "  ")
+}
+
+// SaveSARIF writes diags as a SARIF log to path.
+func SaveSARIF(diags []Diagnostic, path string) error {
+	data, err := SARIF(diags)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// PrintReport prints diags as a human-readable table to stdout, colored if
+// stdout is a terminal and left plain otherwise - the same convention
+// BenchmarkResult.PrintReport uses.
+func PrintReport(configName string, diags []Diagnostic) {
+	t := theme.NoColor()
+	if isTerminal(os.Stdout) {
+		t = theme.Default()
+	}
+	FprintReport(os.Stdout, configName, diags, t)
+}
+
+// FprintReport writes diags as a table to w: one row per diagnostic, sorted
+// by descending severity so errors sort above warnings and info. Pass
+// theme.NoColor() for a destination that isn't a terminal.
+func FprintReport(w io.Writer, configName string, diags []Diagnostic, t *theme.Theme) {
+	headingStyle := lipgloss.NewStyle().Foreground(t.Primary).Bold(true)
+	errorStyle := lipgloss.NewStyle().Foreground(t.Error)
+	warnStyle := lipgloss.NewStyle().Foreground(t.Pending)
+	infoStyle := lipgloss.NewStyle().Foreground(t.Muted)
+
+	fmt.Fprintln(w, headingStyle.Render(fmt.Sprintf("## Lint: %s", configName)))
+
+	if len(diags) == 0 {
+		fmt.Fprintln(w, "  no findings")
+		return
+	}
+
+	sorted := make([]Diagnostic, len(diags))
+	copy(sorted, diags)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Severity > sorted[j].Severity })
+
+	fmt.Fprintf(w, "%-8s %-34s %-20s %s\n", "SEVERITY", "PATH", "RULE", "MESSAGE")
+	fmt.Fprintln(w, strings.Repeat("-", 100))
+
+	errors, warnings := 0, 0
+	for _, d := range sorted {
+		style := infoStyle
+		switch d.Severity {
+		case SeverityError:
+			style = errorStyle
+			errors++
+		case SeverityWarning:
+			style = warnStyle
+			warnings++
+		}
+		fmt.Fprintf(w, "%-8s %-34s %-20s %s\n",
+			style.Render(d.Severity.String()), truncatePath(d.Path, 34), d.Rule, d.Message)
+	}
+
+	fmt.Fprintf(w, "\n%d error(s), %d warning(s), %d total\n", errors, warnings, len(sorted))
+}
+
+func truncatePath(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen-3] + "..."
+}
+
+// isTerminal reports whether f is a terminal device, the same check
+// benchmark.isTerminal uses to decide whether to color a report.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
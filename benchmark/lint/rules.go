@@ -0,0 +1,228 @@
+package lint
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// DefaultRules is the built-in rule set Lint runs when a caller doesn't
+// pick specific ones, e.g. the implicit pre-flight `bench` runs on
+// --config.
+var DefaultRules = []*Rule{
+	CommandMissingDescription,
+	AgentMissingFields,
+	SkillMissingFile,
+	HookSchemaInvalid,
+	DeadSkillReference,
+}
+
+// CommandMissingDescription flags a commands/*.md with no frontmatter, or
+// frontmatter missing the "description" field every command needs to show
+// up in a Claude Code command palette.
+var CommandMissingDescription = &Rule{
+	Name: "commandmissingdescription",
+	Doc:  "reports commands/*.md with no frontmatter, or missing a description field",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		var diags []Diagnostic
+		for _, cmd := range pass.Commands {
+			if !cmd.HasFrontmatter {
+				diags = append(diags, report(pass, Diagnostic{
+					Rule: "commandmissingdescription", Severity: SeverityError, Path: cmd.Path,
+					Message: "command has no \"---\" frontmatter block",
+				}))
+				continue
+			}
+			if !hasStringField(cmd.Frontmatter, "description") {
+				diags = append(diags, report(pass, Diagnostic{
+					Rule: "commandmissingdescription", Severity: SeverityError, Path: cmd.Path,
+					Message: "frontmatter missing required field \"description\"",
+				}))
+			}
+		}
+		return diags, nil
+	},
+}
+
+// AgentMissingFields flags an agents/*.md with no frontmatter, or
+// frontmatter missing "tools" or "model" - the fields a subagent needs to
+// know what it's allowed to call and which model runs it.
+var AgentMissingFields = &Rule{
+	Name: "agentmissingfields",
+	Doc:  "reports agents/*.md with no frontmatter, or missing tools/model fields",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		var diags []Diagnostic
+		for _, agent := range pass.Agents {
+			if !agent.HasFrontmatter {
+				diags = append(diags, report(pass, Diagnostic{
+					Rule: "agentmissingfields", Severity: SeverityError, Path: agent.Path,
+					Message: "agent has no \"---\" frontmatter block",
+				}))
+				continue
+			}
+			if _, ok := agent.Frontmatter["tools"]; !ok {
+				diags = append(diags, report(pass, Diagnostic{
+					Rule: "agentmissingfields", Severity: SeverityError, Path: agent.Path,
+					Message: "frontmatter missing required field \"tools\"",
+				}))
+			}
+			if !hasStringField(agent.Frontmatter, "model") {
+				diags = append(diags, report(pass, Diagnostic{
+					Rule: "agentmissingfields", Severity: SeverityError, Path: agent.Path,
+					Message: "frontmatter missing required field \"model\"",
+				}))
+			}
+		}
+		return diags, nil
+	},
+}
+
+// SkillMissingFile flags a skills/ subdirectory with no SKILL.md, the one
+// file every skill must ship.
+var SkillMissingFile = &Rule{
+	Name: "skillmissingfile",
+	Doc:  "reports skills/ subdirectories with no SKILL.md",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		var diags []Diagnostic
+		for _, skill := range pass.Skills {
+			if skill.HasSkillMD {
+				continue
+			}
+			diags = append(diags, report(pass, Diagnostic{
+				Rule: "skillmissingfile", Severity: SeverityError, Path: skill.Path,
+				Message: "skill directory has no SKILL.md",
+			}))
+		}
+		return diags, nil
+	},
+}
+
+// knownHookMatchers lists the tool names a PreToolUse/PostToolUse hook can
+// actually match against; "*" matches every tool.
+var knownHookMatchers = map[string]bool{
+	"*": true, "Read": true, "Write": true, "Edit": true, "Bash": true,
+	"Glob": true, "Grep": true, "WebFetch": true, "WebSearch": true, "Task": true,
+}
+
+// HookSchemaInvalid flags a settings.json that isn't valid JSON, a hooks
+// event settings.json doesn't recognize, a matcher that isn't a known tool
+// name or a compilable regex, and a hook command whose executable isn't on
+// $PATH.
+var HookSchemaInvalid = &Rule{
+	Name: "hookschemainvalid",
+	Doc:  "reports malformed settings.json hooks: bad JSON, unknown events, bad matchers, missing commands",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		if !pass.HasSettings {
+			return nil, nil
+		}
+
+		var diags []Diagnostic
+		if pass.SettingsError != nil {
+			diags = append(diags, report(pass, Diagnostic{
+				Rule: "hookschemainvalid", Severity: SeverityError, Path: "settings.json",
+				Message: fmt.Sprintf("settings.json is not valid JSON: %v", pass.SettingsError),
+			}))
+			return diags, nil
+		}
+
+		for event, entries := range pass.Settings.Hooks {
+			if !contains(knownEvents, event) {
+				diags = append(diags, report(pass, Diagnostic{
+					Rule: "hookschemainvalid", Severity: SeverityError, Path: "settings.json",
+					Message: fmt.Sprintf("hooks.%s is not a recognized event (want PreToolUse or PostToolUse)", event),
+				}))
+				continue
+			}
+
+			for _, entry := range entries {
+				if entry.Matcher != "" && !knownHookMatchers[entry.Matcher] {
+					if _, err := regexp.Compile(entry.Matcher); err != nil {
+						diags = append(diags, report(pass, Diagnostic{
+							Rule: "hookschemainvalid", Severity: SeverityError, Path: "settings.json",
+							Message: fmt.Sprintf("hooks.%s matcher %q is not a known tool and doesn't compile as a regex: %v", event, entry.Matcher, err),
+						}))
+					}
+				}
+
+				for _, h := range entry.Hooks {
+					if h.Command == "" {
+						diags = append(diags, report(pass, Diagnostic{
+							Rule: "hookschemainvalid", Severity: SeverityError, Path: "settings.json",
+							Message: fmt.Sprintf("hooks.%s entry has a hook with no command", event),
+						}))
+						continue
+					}
+					bin := strings.Fields(h.Command)[0]
+					if _, err := exec.LookPath(bin); err != nil {
+						diags = append(diags, report(pass, Diagnostic{
+							Rule: "hookschemainvalid", Severity: SeverityWarning, Path: "settings.json",
+							Message: fmt.Sprintf("hooks.%s command %q: %q not found on $PATH", event, h.Command, bin),
+						}))
+					}
+				}
+			}
+		}
+		return diags, nil
+	},
+}
+
+// skillReference matches a "skills/<name>" path mentioned in a command's
+// body, the way a command tells a user (or Claude) to go read a skill.
+var skillReference = regexp.MustCompile(`skills/([a-zA-Z0-9_-]+)`)
+
+// DeadSkillReference flags a command that references a skills/<name> path
+// no skill directory actually has, a dangling cross-extension link that
+// would send a reader (or Claude) looking for a skill that isn't there.
+var DeadSkillReference = &Rule{
+	Name: "deadskillreference",
+	Doc:  "reports commands that reference a skills/<name> path with no matching skill",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		known := make(map[string]bool, len(pass.Skills))
+		for _, s := range pass.Skills {
+			known[s.Name] = true
+		}
+
+		var diags []Diagnostic
+		for _, cmd := range pass.Commands {
+			seen := make(map[string]bool)
+			for _, m := range skillReference.FindAllStringSubmatch(cmd.Body, -1) {
+				name := m[1]
+				if known[name] || seen[name] {
+					continue
+				}
+				seen[name] = true
+				diags = append(diags, report(pass, Diagnostic{
+					Rule: "deadskillreference", Severity: SeverityError, Path: cmd.Path,
+					Message: fmt.Sprintf("references missing skill %q", name),
+				}))
+			}
+		}
+		return diags, nil
+	},
+}
+
+// report appends d to Pass.Report's side channel and returns it, so a Run
+// func can build its return slice and call pass.Report in one line.
+func report(pass *Pass, d Diagnostic) Diagnostic {
+	pass.Report(d)
+	return d
+}
+
+func hasStringField(fm map[string]interface{}, key string) bool {
+	v, ok := fm[key]
+	if !ok {
+		return false
+	}
+	s, ok := v.(string)
+	return ok && s != ""
+}
+
+func contains(xs []string, x string) bool {
+	for _, s := range xs {
+		if s == x {
+			return true
+		}
+	}
+	return false
+}
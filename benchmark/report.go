@@ -0,0 +1,357 @@
+package benchmark
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Format selects the on-disk shape SaveReportAs writes.
+type Format int
+
+const (
+	FormatJSON Format = iota
+	FormatMarkdown
+	FormatHTML
+	FormatCSV
+	FormatBenchstat
+)
+
+// SaveReportAs writes the report to path in format, creating or truncating
+// the file as needed.
+func (br *BenchmarkResult) SaveReportAs(path string, format Format) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch format {
+	case FormatMarkdown:
+		return br.RenderMarkdown(f)
+	case FormatHTML:
+		return br.RenderHTML(f)
+	case FormatCSV:
+		return br.RenderCSV(f)
+	case FormatBenchstat:
+		return br.RenderBenchstat(f)
+	default:
+		return br.SaveReport(path)
+	}
+}
+
+// sortedConfigNames returns the config names in alphabetical order, so the
+// renderers below produce deterministic output instead of following Go's
+// randomized map iteration.
+func (br *BenchmarkResult) sortedConfigNames() []string {
+	names := make([]string, 0, len(br.ConfigResults))
+	for name := range br.ConfigResults {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SortedConfigNames returns the config names in alphabetical order. Exported
+// for callers outside the package, such as htmlreport, that need the same
+// deterministic ordering the renderers in this file use.
+func (br *BenchmarkResult) SortedConfigNames() []string {
+	return br.sortedConfigNames()
+}
+
+// RenderMarkdown writes the report as GitHub-flavored Markdown, suitable for
+// a CI job summary.
+func (br *BenchmarkResult) RenderMarkdown(w io.Writer) error {
+	fmt.Fprintf(w, "# Benchmark Report: %s\n\n", br.CorpusName)
+	fmt.Fprintf(w, "Version: %s | Run: %s | Duration: %s\n\n",
+		br.CorpusVersion, br.Timestamp.Format("2006-01-02 15:04"), br.Duration.Round(time.Second))
+
+	names := br.sortedConfigNames()
+
+	fmt.Fprintln(w, "## Summary by Configuration")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Config | Success | 95% CI | Score | Duration |")
+	fmt.Fprintln(w, "|---|---|---|---|---|")
+	for _, name := range names {
+		cr := br.ConfigResults[name]
+		ci := wilsonInterval(cr.SuccessCount, cr.TotalIssues)
+		fmt.Fprintf(w, "| %s | %.0f%% | [%.0f%%, %.0f%%] | %.0f%% | %s |\n",
+			name, cr.SuccessRate*100, ci[0]*100, ci[1]*100, cr.AverageScore*100, cr.TotalDuration.Round(time.Second))
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "## Success Rate by Difficulty")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Config | Easy | Medium | Hard |")
+	fmt.Fprintln(w, "|---|---|---|---|")
+	for _, name := range names {
+		cr := br.ConfigResults[name]
+		easy := getRate(cr.ByDifficulty[DifficultyEasy])
+		medium := getRate(cr.ByDifficulty[DifficultyMedium])
+		hard := getRate(cr.ByDifficulty[DifficultyHard])
+		fmt.Fprintf(w, "| %s | %.0f%% | %.0f%% | %.0f%% |\n", name, easy*100, medium*100, hard*100)
+	}
+	fmt.Fprintln(w)
+
+	if _, ok := br.ConfigResults["baseline"]; ok {
+		fmt.Fprintln(w, "## Improvement vs Baseline")
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "| Config | Delta | 95% CI | p-value |")
+		fmt.Fprintln(w, "|---|---|---|---|")
+		for _, name := range names {
+			if name == "baseline" {
+				continue
+			}
+			cmp := br.Compare("baseline", name)
+			sign := "+"
+			if cmp.Improvement < 0 {
+				sign = ""
+			}
+			fmt.Fprintf(w, "| %s | %s%.1fpp | [%.1f, %.1f] | %.3f |\n",
+				name, sign, cmp.Improvement, cmp.DeltaCI[0], cmp.DeltaCI[1], cmp.PValue)
+		}
+		fmt.Fprintln(w)
+	}
+
+	return nil
+}
+
+// RenderBenchstat writes a benchstat-style text table comparing each
+// non-baseline config against "baseline" on a per-issue basis, using the
+// per-trial scores collected when BenchmarkRunner.Trials > 1. Issues run
+// with a single trial still get a row, just without a meaningful p-value.
+// Does nothing (writes nothing) when there is no "baseline" config to
+// compare against.
+func (br *BenchmarkResult) RenderBenchstat(w io.Writer) error {
+	if _, ok := br.ConfigResults["baseline"]; !ok {
+		return nil
+	}
+
+	names := br.sortedConfigNames()
+	for _, name := range names {
+		if name == "baseline" {
+			continue
+		}
+
+		cmp := br.CompareTrials("baseline", name)
+		if cmp == nil {
+			continue
+		}
+
+		fmt.Fprintf(w, "name \\ score     old        new        delta\n")
+		for _, ic := range cmp.Issues {
+			sig := " "
+			if ic.Significant {
+				sig = "*"
+			}
+			fmt.Fprintf(w, "%-16s  %-9.3f  %-9.3f  %+.1f%%%s (p=%.3f)\n",
+				ic.IssueID, ic.BaselineMean, ic.TestMean, ic.Delta, sig, ic.PValue)
+		}
+		fmt.Fprintf(w, "%-16s  %-9s  %-9s  %+.1f%% [%.1f, %.1f]\n\n",
+			name, "", "mean", cmp.Delta, cmp.DeltaCI[0], cmp.DeltaCI[1])
+	}
+
+	return nil
+}
+
+// RenderCSV writes one row per IssueResult across all configs, so the raw
+// results can be loaded into a spreadsheet for ad hoc analysis.
+func (br *BenchmarkResult) RenderCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{
+		"config", "issue_id", "difficulty", "task_type", "language",
+		"success", "score", "duration_seconds", "error",
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, name := range br.sortedConfigNames() {
+		for _, ir := range br.ConfigResults[name].IssueResults {
+			row := []string{
+				name,
+				ir.IssueID,
+				string(ir.Difficulty),
+				string(ir.TaskType),
+				ir.Language,
+				strconv.FormatBool(ir.Success),
+				strconv.FormatFloat(ir.Score, 'f', 4, 64),
+				strconv.FormatFloat(ir.Duration.Seconds(), 'f', 3, 64),
+				ir.Error,
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// htmlReportData is the view model fed to htmlReportTemplate.
+type htmlReportData struct {
+	CorpusName    string
+	CorpusVersion string
+	Timestamp     string
+	Duration      string
+	Configs       []htmlConfigView
+}
+
+// htmlConfigView is one config's row in the summary table plus the bar
+// charts rendered under it.
+type htmlConfigView struct {
+	Name             string
+	SuccessPct       float64
+	ScorePct         float64
+	Duration         string
+	DifficultyBars   []htmlBar
+	DifficultyHeight int
+	TaskTypeBars     []htmlBar
+	TaskTypeHeight   int
+}
+
+// barRowHeight is the pixel height each htmlBar's row reserves in the SVG.
+const barRowHeight = 20
+
+// htmlBar is a single labeled bar in an SVG bar chart. Pct is in [0, 100]
+// and Y is the bar's precomputed top offset, since html/template has no
+// arithmetic of its own.
+type htmlBar struct {
+	Label string
+	Pct   float64
+	Y     int
+}
+
+func buildHTMLBars[T ~string](order []T, rate func(T) float64) []htmlBar {
+	bars := make([]htmlBar, len(order))
+	for i, key := range order {
+		bars[i] = htmlBar{Label: string(key), Pct: rate(key), Y: i * barRowHeight}
+	}
+	return bars
+}
+
+var difficultyOrder = []Difficulty{DifficultyEasy, DifficultyMedium, DifficultyHard}
+
+var taskTypeOrder = []TaskType{TaskBugFix, TaskFeature, TaskRefactor, TaskTest, TaskDocumentation}
+
+func (br *BenchmarkResult) buildHTMLReportData() htmlReportData {
+	data := htmlReportData{
+		CorpusName:    br.CorpusName,
+		CorpusVersion: br.CorpusVersion,
+		Timestamp:     br.Timestamp.Format("2006-01-02 15:04"),
+		Duration:      br.Duration.Round(time.Second).String(),
+	}
+
+	for _, name := range br.sortedConfigNames() {
+		cr := br.ConfigResults[name]
+
+		difficultyBars := buildHTMLBars(difficultyOrder, func(d Difficulty) float64 {
+			return getRate(cr.ByDifficulty[d]) * 100
+		})
+		taskTypeBars := buildHTMLBars(taskTypeOrder, func(t TaskType) float64 {
+			if stats := cr.ByTaskType[t]; stats != nil {
+				return stats.SuccessRate * 100
+			}
+			return 0
+		})
+
+		data.Configs = append(data.Configs, htmlConfigView{
+			Name:             name,
+			SuccessPct:       cr.SuccessRate * 100,
+			ScorePct:         cr.AverageScore * 100,
+			Duration:         cr.TotalDuration.Round(time.Second).String(),
+			DifficultyBars:   difficultyBars,
+			DifficultyHeight: len(difficultyBars) * barRowHeight,
+			TaskTypeBars:     taskTypeBars,
+			TaskTypeHeight:   len(taskTypeBars) * barRowHeight,
+		})
+	}
+
+	return data
+}
+
+// RenderHTML writes the report as a self-contained HTML page: sortable
+// summary tables plus a small SVG bar chart per config, broken down by
+// difficulty and by task type.
+func (br *BenchmarkResult) RenderHTML(w io.Writer) error {
+	return htmlReportTemplate.Execute(w, br.buildHTMLReportData())
+}
+
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Benchmark Report: {{.CorpusName}}</title>
+<style>
+  body { font-family: -apple-system, sans-serif; margin: 2rem; color: #1a1a1a; }
+  h1, h2 { color: #1a1a1a; }
+  table { border-collapse: collapse; margin-bottom: 1.5rem; }
+  th, td { border: 1px solid #ccc; padding: 0.4rem 0.8rem; text-align: left; }
+  th { cursor: pointer; background: #f4f4f4; user-select: none; }
+  th:hover { background: #e8e8e8; }
+  .chart-label { font-size: 0.8rem; }
+  .config-section { margin-bottom: 2rem; }
+</style>
+<script>
+function sortTable(table, col) {
+  var rows = Array.from(table.tBodies[0].rows);
+  var asc = table.dataset.sortCol == col ? table.dataset.sortDir !== 'asc' : true;
+  rows.sort(function(a, b) {
+    var x = a.cells[col].innerText, y = b.cells[col].innerText;
+    var nx = parseFloat(x), ny = parseFloat(y);
+    var cmp = (!isNaN(nx) && !isNaN(ny)) ? nx - ny : x.localeCompare(y);
+    return asc ? cmp : -cmp;
+  });
+  rows.forEach(function(r) { table.tBodies[0].appendChild(r); });
+  table.dataset.sortCol = col;
+  table.dataset.sortDir = asc ? 'asc' : 'desc';
+}
+function makeSortable(id) {
+  var table = document.getElementById(id);
+  Array.from(table.tHead.rows[0].cells).forEach(function(th, i) {
+    th.addEventListener('click', function() { sortTable(table, i); });
+  });
+}
+window.addEventListener('DOMContentLoaded', function() {
+  makeSortable('summary-table');
+});
+</script>
+</head>
+<body>
+<h1>Benchmark Report: {{.CorpusName}}</h1>
+<p>Version: {{.CorpusVersion}} | Run: {{.Timestamp}} | Duration: {{.Duration}}</p>
+
+<h2>Summary by Configuration</h2>
+<table id="summary-table">
+<thead><tr><th>Config</th><th>Success %</th><th>Score %</th><th>Duration</th></tr></thead>
+<tbody>
+{{range .Configs}}<tr><td>{{.Name}}</td><td>{{printf "%.0f" .SuccessPct}}</td><td>{{printf "%.0f" .ScorePct}}</td><td>{{.Duration}}</td></tr>
+{{end}}</tbody>
+</table>
+
+{{range .Configs}}
+<div class="config-section">
+<h2>{{.Name}}</h2>
+
+<h3>Success rate by difficulty</h3>
+<svg width="320" height="{{.DifficultyHeight}}" viewBox="0 0 320 {{.DifficultyHeight}}">
+{{range .DifficultyBars}}<rect x="80" y="{{.Y}}" width="{{.Pct}}" height="16" fill="#FFD700"></rect><text class="chart-label" x="0" y="{{.Y}}" dy="12">{{.Label}}</text><text class="chart-label" x="{{.Pct}}" y="{{.Y}}" dx="85" dy="12">{{printf "%.0f" .Pct}}%</text>
+{{end}}</svg>
+
+<h3>Success rate by task type</h3>
+<svg width="320" height="{{.TaskTypeHeight}}" viewBox="0 0 320 {{.TaskTypeHeight}}">
+{{range .TaskTypeBars}}<rect x="100" y="{{.Y}}" width="{{.Pct}}" height="16" fill="#00D9FF"></rect><text class="chart-label" x="0" y="{{.Y}}" dy="12">{{.Label}}</text><text class="chart-label" x="{{.Pct}}" y="{{.Y}}" dx="105" dy="12">{{printf "%.0f" .Pct}}%</text>
+{{end}}</svg>
+</div>
+{{end}}
+
+</body>
+</html>
+`))
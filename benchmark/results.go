@@ -3,18 +3,27 @@ package benchmark
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"math/rand"
 	"os"
+	"sort"
 	"strings"
 	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/theme"
 )
 
 // BenchmarkResult contains the complete results of a benchmark run.
 type BenchmarkResult struct {
-	Timestamp     time.Time                 `json:"timestamp"`
-	CorpusName    string                    `json:"corpus_name"`
-	CorpusVersion string                    `json:"corpus_version"`
-	Duration      time.Duration             `json:"duration"`
-	ConfigResults map[string]*ConfigResult  `json:"config_results"`
+	Timestamp     time.Time                `json:"timestamp"`
+	CorpusName    string                   `json:"corpus_name"`
+	CorpusVersion string                   `json:"corpus_version"`
+	Duration      time.Duration            `json:"duration"`
+	ConfigResults map[string]*ConfigResult `json:"config_results"`
 }
 
 // ConfigResult contains results for a single configuration.
@@ -23,11 +32,11 @@ type ConfigResult struct {
 	IssueResults []*IssueResult `json:"issue_results"`
 
 	// Aggregate stats
-	TotalIssues    int     `json:"total_issues"`
-	SuccessCount   int     `json:"success_count"`
-	SuccessRate    float64 `json:"success_rate"`
-	AverageScore   float64 `json:"average_score"`
-	TotalDuration  time.Duration `json:"total_duration"`
+	TotalIssues   int           `json:"total_issues"`
+	SuccessCount  int           `json:"success_count"`
+	SuccessRate   float64       `json:"success_rate"`
+	AverageScore  float64       `json:"average_score"`
+	TotalDuration time.Duration `json:"total_duration"`
 
 	// Breakdown by difficulty
 	ByDifficulty map[Difficulty]*DifficultyStats `json:"by_difficulty"`
@@ -37,9 +46,19 @@ type ConfigResult struct {
 
 	// Breakdown by language
 	ByLanguage map[string]*LanguageStats `json:"by_language"`
+
+	// Efficiency aggregates, from each IssueResult's SessionTrace-derived
+	// fields. Zero across the board when runs predate stream-json capture
+	// (or used --output-format other than stream-json).
+	AverageInputTokens  float64 `json:"average_input_tokens"`
+	AverageOutputTokens float64 `json:"average_output_tokens"`
+	AverageToolCalls    float64 `json:"average_tool_calls"`
+	TotalCostUSD        float64 `json:"total_cost_usd"`
 }
 
-// IssueResult contains the result for a single issue.
+// IssueResult contains the result for a single issue. Score/Duration/
+// Success are aggregated (mean/mean/majority-vote) across Trials when
+// BenchmarkRunner.Trials > 1; see aggregateTrials.
 type IssueResult struct {
 	IssueID      string        `json:"issue_id"`
 	ConfigName   string        `json:"config_name"`
@@ -53,6 +72,45 @@ type IssueResult struct {
 	Error        string        `json:"error,omitempty"`
 	Duration     time.Duration `json:"duration"`
 	WorkDir      string        `json:"work_dir"` // For debugging
+
+	// Trials holds one entry per independent run of this issue under this
+	// config. Always has at least one entry; len(Trials) == 1 when
+	// BenchmarkRunner.Trials was left at its default.
+	Trials []TrialResult `json:"trials,omitempty"`
+
+	// Trace is the representative trial's SessionTrace (the same trial
+	// ClaudeOutput/EvalDetails/Error come from; see runIssue). Nil when the
+	// run didn't use --output-format stream-json.
+	Trace *SessionTrace `json:"trace,omitempty"`
+
+	// InputTokens/OutputTokens/CostUSD/ToolCallCount are the mean across
+	// Trials (see aggregateTrials), so ConfigResult.calculateStats can roll
+	// them up into AverageInputTokens etc. without re-deriving from Trials.
+	InputTokens   float64 `json:"input_tokens"`
+	OutputTokens  float64 `json:"output_tokens"`
+	CostUSD       float64 `json:"cost_usd"`
+	ToolCallCount float64 `json:"tool_call_count"`
+}
+
+// TrialResult is one independent execution of an issue under a config.
+type TrialResult struct {
+	Success  bool          `json:"success"`
+	Score    float64       `json:"score"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+
+	// Trace is nil when the run didn't use --output-format stream-json.
+	Trace *SessionTrace `json:"trace,omitempty"`
+
+	// ModifiedFiles is the set of repo-relative paths Claude's session
+	// changed, diffed against the pre-edit checkout. Empty when the issue
+	// defines no ExpectedFiles to diff against.
+	ModifiedFiles []string `json:"modified_files,omitempty"`
+
+	// FileOverlap is the Jaccard similarity between ModifiedFiles and the
+	// issue's ExpectedFiles; see jaccardScore. Zero when ExpectedFiles is
+	// unset.
+	FileOverlap float64 `json:"file_overlap,omitempty"`
 }
 
 // DifficultyStats contains aggregate stats by difficulty.
@@ -88,6 +146,7 @@ func (cr *ConfigResult) calculateStats() {
 
 	var totalScore float64
 	var totalDuration time.Duration
+	var totalInputTokens, totalOutputTokens, totalToolCalls float64
 
 	for _, ir := range cr.IssueResults {
 		if ir.Success {
@@ -95,6 +154,10 @@ func (cr *ConfigResult) calculateStats() {
 		}
 		totalScore += ir.Score
 		totalDuration += ir.Duration
+		totalInputTokens += ir.InputTokens
+		totalOutputTokens += ir.OutputTokens
+		totalToolCalls += ir.ToolCallCount
+		cr.TotalCostUSD += ir.CostUSD
 
 		// By difficulty
 		if cr.ByDifficulty[ir.Difficulty] == nil {
@@ -136,6 +199,9 @@ func (cr *ConfigResult) calculateStats() {
 	if cr.TotalIssues > 0 {
 		cr.SuccessRate = float64(cr.SuccessCount) / float64(cr.TotalIssues)
 		cr.AverageScore = totalScore / float64(cr.TotalIssues)
+		cr.AverageInputTokens = totalInputTokens / float64(cr.TotalIssues)
+		cr.AverageOutputTokens = totalOutputTokens / float64(cr.TotalIssues)
+		cr.AverageToolCalls = totalToolCalls / float64(cr.TotalIssues)
 	}
 	cr.TotalDuration = totalDuration
 }
@@ -147,10 +213,27 @@ type Comparison struct {
 	BaselineRate   float64 `json:"baseline_rate"`
 	TestRate       float64 `json:"test_rate"`
 	Improvement    float64 `json:"improvement"` // Percentage points
-	Significant    bool    `json:"significant"` // Basic significance check
+
+	// PValue is the two-sided p-value of McNemar's test over the paired
+	// issues both configs ran, testing whether the configs' successes
+	// disagree more than chance would predict.
+	PValue float64 `json:"p_value"`
+	// Significant is PValue < 0.05.
+	Significant bool `json:"significant"`
+
+	// BaselineCI and TestCI are 95% Wilson score confidence intervals
+	// around BaselineRate and TestRate.
+	BaselineCI [2]float64 `json:"baseline_ci"`
+	TestCI     [2]float64 `json:"test_ci"`
+	// DeltaCI is a 95% bootstrap confidence interval (1000 resamples over
+	// the paired issue IDs) around Improvement.
+	DeltaCI [2]float64 `json:"delta_ci"`
 }
 
-// Compare generates a comparison between two configs.
+// Compare generates a comparison between two configs, including McNemar's
+// exact test over the issues both configs ran (falling back to the
+// large-sample chi-squared approximation past 25 discordant pairs), a
+// Wilson score CI around each rate, and a bootstrap CI around the delta.
 func (br *BenchmarkResult) Compare(baselineName, testName string) *Comparison {
 	baseline := br.ConfigResults[baselineName]
 	test := br.ConfigResults[testName]
@@ -161,74 +244,533 @@ func (br *BenchmarkResult) Compare(baselineName, testName string) *Comparison {
 
 	improvement := (test.SuccessRate - baseline.SuccessRate) * 100
 
+	baselineSuccess := successByIssue(baseline)
+	testSuccess := successByIssue(test)
+	pairedIDs := pairedIssueIDs(baselineSuccess, testSuccess)
+
+	var b, c int // b: baseline-only successes, c: test-only successes
+	for _, id := range pairedIDs {
+		switch {
+		case baselineSuccess[id] && !testSuccess[id]:
+			b++
+		case !baselineSuccess[id] && testSuccess[id]:
+			c++
+		}
+	}
+	pValue := mcNemarPValue(b, c)
+
 	return &Comparison{
 		BaselineConfig: baselineName,
 		TestConfig:     testName,
 		BaselineRate:   baseline.SuccessRate,
 		TestRate:       test.SuccessRate,
 		Improvement:    improvement,
-		Significant:    abs(improvement) >= 5, // Basic threshold
+		PValue:         pValue,
+		Significant:    pValue < 0.05,
+		BaselineCI:     wilsonInterval(baseline.SuccessCount, baseline.TotalIssues),
+		TestCI:         wilsonInterval(test.SuccessCount, test.TotalIssues),
+		DeltaCI:        bootstrapDeltaCI(baselineSuccess, testSuccess, pairedIDs),
+	}
+}
+
+// successByIssue indexes a config's results by IssueID for pairing against
+// another config's results.
+func successByIssue(cr *ConfigResult) map[string]bool {
+	m := make(map[string]bool, len(cr.IssueResults))
+	for _, ir := range cr.IssueResults {
+		m[ir.IssueID] = ir.Success
+	}
+	return m
+}
+
+// pairedIssueIDs returns the issue IDs present in both result sets, in
+// sorted order so callers (and tests) get a deterministic iteration order.
+func pairedIssueIDs(a, b map[string]bool) []string {
+	return pairedKeys(a, b)
+}
+
+// pairedKeys returns the keys present in both maps, sorted, regardless of
+// value type.
+func pairedKeys[V any](a, b map[string]V) []string {
+	var ids []string
+	for id := range a {
+		if _, ok := b[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// mcNemarPValue computes the two-sided p-value of McNemar's test from the
+// discordant pair counts b and c. Up to 25 discordant pairs it sums the
+// exact binomial distribution; above that it uses the continuity-corrected
+// chi-squared approximation (1 degree of freedom).
+func mcNemarPValue(b, c int) float64 {
+	n := b + c
+	if n == 0 {
+		return 1.0
+	}
+
+	if n > 25 {
+		chi2 := math.Pow(math.Abs(float64(b-c))-1, 2) / float64(n)
+		return math.Erfc(math.Sqrt(chi2 / 2))
+	}
+
+	k := b
+	if c < k {
+		k = c
+	}
+
+	var sum big.Float
+	var term big.Int
+	for i := 0; i <= k; i++ {
+		term.Binomial(int64(n), int64(i))
+		f := new(big.Float).SetInt(&term)
+		sum.Add(&sum, f)
+	}
+	sumF, _ := sum.Float64()
+
+	p := 2 * sumF * math.Pow(0.5, float64(n))
+	if p > 1.0 {
+		p = 1.0
+	}
+	return p
+}
+
+// wilsonInterval returns the 95% Wilson score confidence interval for a
+// successes-out-of-total proportion.
+func wilsonInterval(successes, total int) [2]float64 {
+	if total == 0 {
+		return [2]float64{0, 0}
+	}
+
+	const z = 1.959963984540054 // 97.5th percentile of the standard normal
+	n := float64(total)
+	p := float64(successes) / n
+
+	denom := 1 + z*z/n
+	center := p + z*z/(2*n)
+	margin := z * math.Sqrt(p*(1-p)/n+z*z/(4*n*n))
+
+	lo := (center - margin) / denom
+	hi := (center + margin) / denom
+	if lo < 0 {
+		lo = 0
+	}
+	if hi > 1 {
+		hi = 1
+	}
+	return [2]float64{lo, hi}
+}
+
+// bootstrapDeltaCI resamples (with replacement) the paired issue IDs 1000
+// times and returns the 2.5th/97.5th percentile of the resulting
+// test-minus-baseline success-rate delta, in percentage points.
+func bootstrapDeltaCI(baselineSuccess, testSuccess map[string]bool, ids []string) [2]float64 {
+	n := len(ids)
+	if n == 0 {
+		return [2]float64{0, 0}
+	}
+
+	const resamples = 1000
+	rng := rand.New(rand.NewSource(1))
+	deltas := make([]float64, resamples)
+
+	for r := 0; r < resamples; r++ {
+		var baseSucc, testSucc int
+		for i := 0; i < n; i++ {
+			id := ids[rng.Intn(n)]
+			if baselineSuccess[id] {
+				baseSucc++
+			}
+			if testSuccess[id] {
+				testSucc++
+			}
+		}
+		deltas[r] = float64(testSucc-baseSucc) / float64(n) * 100
+	}
+
+	sort.Float64s(deltas)
+	lo := deltas[int(0.025*float64(resamples))]
+	hi := deltas[int(0.975*float64(resamples))-1]
+	return [2]float64{lo, hi}
+}
+
+// IssueComparison is a benchstat-style Welch's t-test comparison of a
+// single issue's per-trial scores between two configs.
+type IssueComparison struct {
+	IssueID        string  `json:"issue_id"`
+	BaselineMean   float64 `json:"baseline_mean"`
+	BaselineStdDev float64 `json:"baseline_stddev"`
+	TestMean       float64 `json:"test_mean"`
+	TestStdDev     float64 `json:"test_stddev"`
+	// Delta is the percent change in mean score, test vs baseline.
+	Delta       float64 `json:"delta"`
+	PValue      float64 `json:"p_value"`
+	Significant bool    `json:"significant"`
+}
+
+// TrialComparison is the corpus-wide, benchstat-style comparison between
+// two configs' per-trial scores: one IssueComparison per paired issue, plus
+// an aggregate delta across all of them.
+type TrialComparison struct {
+	BaselineConfig string            `json:"baseline_config"`
+	TestConfig     string            `json:"test_config"`
+	Issues         []IssueComparison `json:"issues"`
+
+	// Delta is the mean of each issue's percent delta. DeltaCI is its 95%
+	// bootstrap confidence interval (1000 resamples over paired issues).
+	Delta   float64    `json:"delta"`
+	DeltaCI [2]float64 `json:"delta_ci"`
+}
+
+// CompareTrials compares baselineName against testName using each paired
+// issue's per-trial scores: a Welch's t-test per issue, and an aggregate
+// delta with a bootstrap confidence interval across the corpus. Unlike
+// Compare (which compares whole-config success rates), this looks at the
+// continuous Score across BenchmarkRunner.Trials repetitions, so it needs
+// more than one trial per issue to say anything about significance.
+func (br *BenchmarkResult) CompareTrials(baselineName, testName string) *TrialComparison {
+	baseline := br.ConfigResults[baselineName]
+	test := br.ConfigResults[testName]
+	if baseline == nil || test == nil {
+		return nil
+	}
+
+	baselineScores := trialScoresByIssue(baseline)
+	testScores := trialScoresByIssue(test)
+	ids := pairedKeys(baselineScores, testScores)
+
+	cmp := &TrialComparison{BaselineConfig: baselineName, TestConfig: testName}
+	deltas := make([]float64, 0, len(ids))
+
+	for _, id := range ids {
+		bScores := baselineScores[id]
+		tScores := testScores[id]
+
+		bMean, bStdDev := meanStdDev(bScores)
+		tMean, tStdDev := meanStdDev(tScores)
+		delta := percentDelta(bMean, tMean)
+		deltas = append(deltas, delta)
+
+		cmp.Issues = append(cmp.Issues, IssueComparison{
+			IssueID:        id,
+			BaselineMean:   bMean,
+			BaselineStdDev: bStdDev,
+			TestMean:       tMean,
+			TestStdDev:     tStdDev,
+			Delta:          delta,
+			PValue:         welchTTest(bScores, tScores),
+			Significant:    welchTTest(bScores, tScores) < 0.05,
+		})
+	}
+
+	if len(deltas) > 0 {
+		var sum float64
+		for _, d := range deltas {
+			sum += d
+		}
+		cmp.Delta = sum / float64(len(deltas))
+		cmp.DeltaCI = bootstrapMeanCI(deltas)
+	}
+
+	return cmp
+}
+
+// trialScoresByIssue indexes a config's per-trial scores by IssueID. Issues
+// run without per-trial detail (Trials unset) fall back to their single
+// aggregate Score.
+func trialScoresByIssue(cr *ConfigResult) map[string][]float64 {
+	m := make(map[string][]float64, len(cr.IssueResults))
+	for _, ir := range cr.IssueResults {
+		if len(ir.Trials) == 0 {
+			m[ir.IssueID] = []float64{ir.Score}
+			continue
+		}
+		scores := make([]float64, len(ir.Trials))
+		for i, t := range ir.Trials {
+			scores[i] = t.Score
+		}
+		m[ir.IssueID] = scores
+	}
+	return m
+}
+
+// percentDelta is the percent change from baseline to test, 0 when
+// baseline is 0 (avoiding a divide-by-zero rather than reporting ±Inf).
+func percentDelta(baseline, test float64) float64 {
+	if baseline == 0 {
+		return 0
+	}
+	return (test - baseline) / baseline * 100
+}
+
+// meanStdDev returns the sample mean and (Bessel-corrected) standard
+// deviation of xs. stddev is 0 for fewer than two samples.
+func meanStdDev(xs []float64) (mean, stddev float64) {
+	n := float64(len(xs))
+	if n == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	mean = sum / n
+	if n < 2 {
+		return mean, 0
+	}
+
+	var sq float64
+	for _, x := range xs {
+		d := x - mean
+		sq += d * d
 	}
+	return mean, math.Sqrt(sq / (n - 1))
 }
 
-func abs(x float64) float64 {
-	if x < 0 {
-		return -x
+// welchTTest returns the two-sided p-value of Welch's t-test comparing the
+// means of a and b (unequal variances assumed), using the Welch-
+// Satterthwaite degrees of freedom and the regularized incomplete beta
+// function to evaluate the Student's t distribution. Returns 1.0 (no
+// evidence of a difference) when either sample has fewer than 2 points.
+func welchTTest(a, b []float64) float64 {
+	if len(a) < 2 || len(b) < 2 {
+		return 1.0
 	}
-	return x
+
+	meanA, sdA := meanStdDev(a)
+	meanB, sdB := meanStdDev(b)
+	nA, nB := float64(len(a)), float64(len(b))
+	seA, seB := sdA*sdA/nA, sdB*sdB/nB
+
+	se := seA + seB
+	if se == 0 {
+		return 1.0
+	}
+
+	t := (meanA - meanB) / math.Sqrt(se)
+	df := se * se / (seA*seA/(nA-1) + seB*seB/(nB-1))
+
+	x := df / (df + t*t)
+	return incompleteBeta(df/2, 0.5, x)
 }
 
-// PrintReport prints a human-readable report.
+// incompleteBeta computes the regularized incomplete beta function I_x(a, b)
+// via its continued fraction expansion, used to turn a Student's t
+// statistic into a p-value without pulling in a stats library.
+func incompleteBeta(a, b, x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+
+	lgA, _ := math.Lgamma(a)
+	lgB, _ := math.Lgamma(b)
+	lgAB, _ := math.Lgamma(a + b)
+	front := math.Exp(lgAB - lgA - lgB + a*math.Log(x) + b*math.Log(1-x))
+
+	if x < (a+1)/(a+b+2) {
+		return front * betacf(a, b, x) / a
+	}
+	return 1 - front*betacf(b, a, 1-x)/b
+}
+
+// betacf evaluates the continued fraction for the incomplete beta function
+// by the modified Lentz method (Numerical Recipes §6.4).
+func betacf(a, b, x float64) float64 {
+	const (
+		maxIter = 200
+		eps     = 3e-14
+		tiny    = 1e-300
+	)
+
+	qab := a + b
+	qap := a + 1
+	qam := a - 1
+
+	c := 1.0
+	d := 1 - qab*x/qap
+	if math.Abs(d) < tiny {
+		d = tiny
+	}
+	d = 1 / d
+	h := d
+
+	for m := 1; m <= maxIter; m++ {
+		mf := float64(m)
+		m2 := 2 * mf
+
+		aa := mf * (b - mf) * x / ((qam + m2) * (a + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		h *= d * c
+
+		aa = -(a + mf) * (qab + mf) * x / ((a + m2) * (qap + m2))
+		d = 1 + aa*d
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = 1 + aa/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+
+		if math.Abs(del-1) < eps {
+			break
+		}
+	}
+
+	return h
+}
+
+// bootstrapMeanCI resamples xs (with replacement) 1000 times and returns
+// the 2.5th/97.5th percentile of the resampled mean.
+func bootstrapMeanCI(xs []float64) [2]float64 {
+	n := len(xs)
+	if n == 0 {
+		return [2]float64{0, 0}
+	}
+
+	const resamples = 1000
+	rng := rand.New(rand.NewSource(1))
+	means := make([]float64, resamples)
+
+	for r := 0; r < resamples; r++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			sum += xs[rng.Intn(n)]
+		}
+		means[r] = sum / float64(n)
+	}
+
+	sort.Float64s(means)
+	lo := means[int(0.025*float64(resamples))]
+	hi := means[int(0.975*float64(resamples))-1]
+	return [2]float64{lo, hi}
+}
+
+// PrintReport prints a human-readable report to stdout, colored if stdout is
+// a terminal and left plain otherwise (e.g. when redirected to a file).
 func (br *BenchmarkResult) PrintReport() {
-	fmt.Println("=" + strings.Repeat("=", 60))
-	fmt.Printf("BENCHMARK REPORT: %s\n", br.CorpusName)
-	fmt.Printf("Version: %s | Run: %s | Duration: %s\n",
+	t := theme.NoColor()
+	if isTerminal(os.Stdout) {
+		t = theme.Default()
+	}
+	br.FprintReport(os.Stdout, t)
+}
+
+// FprintReport writes a human-readable report to w, coloring section
+// headers and rates through t. Pass theme.NoColor() for a destination that
+// isn't a terminal, such as a saved log file.
+func (br *BenchmarkResult) FprintReport(w io.Writer, t *theme.Theme) {
+	headingStyle := lipgloss.NewStyle().Foreground(t.Primary).Bold(true)
+	goodStyle := lipgloss.NewStyle().Foreground(t.Success)
+	badStyle := lipgloss.NewStyle().Foreground(t.Error)
+
+	fmt.Fprintln(w, "="+strings.Repeat("=", 60))
+	fmt.Fprintf(w, "BENCHMARK REPORT: %s\n", br.CorpusName)
+	fmt.Fprintf(w, "Version: %s | Run: %s | Duration: %s\n",
 		br.CorpusVersion, br.Timestamp.Format("2006-01-02 15:04"), br.Duration.Round(time.Second))
-	fmt.Println(strings.Repeat("=", 61))
+	fmt.Fprintln(w, strings.Repeat("=", 61))
 
 	// Summary table
-	fmt.Println("\n## Summary by Configuration\n")
-	fmt.Printf("%-20s %8s %8s %10s\n", "Config", "Success", "Score", "Duration")
-	fmt.Println(strings.Repeat("-", 50))
+	fmt.Fprintln(w, "\n"+headingStyle.Render("## Summary by Configuration")+"\n")
+	fmt.Fprintf(w, "%-20s %8s %18s %8s %10s\n", "Config", "Success", "95% CI", "Score", "Duration")
+	fmt.Fprintln(w, strings.Repeat("-", 68))
 
 	for name, cr := range br.ConfigResults {
-		fmt.Printf("%-20s %7.0f%% %7.0f%% %10s\n",
+		ci := wilsonInterval(cr.SuccessCount, cr.TotalIssues)
+		fmt.Fprintf(w, "%-20s %7s %17s %7.0f%% %10s\n",
 			truncateName(name, 20),
-			cr.SuccessRate*100,
+			rateStyle(cr.SuccessRate, goodStyle, badStyle).Render(fmt.Sprintf("%.0f%%", cr.SuccessRate*100)),
+			fmt.Sprintf("[%.0f%%, %.0f%%]", ci[0]*100, ci[1]*100),
 			cr.AverageScore*100,
 			cr.TotalDuration.Round(time.Second))
 	}
 
 	// By difficulty breakdown
-	fmt.Println("\n## Success Rate by Difficulty\n")
-	fmt.Printf("%-20s %10s %10s %10s\n", "Config", "Easy", "Medium", "Hard")
-	fmt.Println(strings.Repeat("-", 55))
+	fmt.Fprintln(w, "\n"+headingStyle.Render("## Success Rate by Difficulty")+"\n")
+	fmt.Fprintf(w, "%-20s %10s %10s %10s\n", "Config", "Easy", "Medium", "Hard")
+	fmt.Fprintln(w, strings.Repeat("-", 55))
 
 	for name, cr := range br.ConfigResults {
 		easy := getRate(cr.ByDifficulty[DifficultyEasy])
 		medium := getRate(cr.ByDifficulty[DifficultyMedium])
 		hard := getRate(cr.ByDifficulty[DifficultyHard])
-		fmt.Printf("%-20s %9.0f%% %9.0f%% %9.0f%%\n",
+		fmt.Fprintf(w, "%-20s %9.0f%% %9.0f%% %9.0f%%\n",
 			truncateName(name, 20), easy*100, medium*100, hard*100)
 	}
 
 	// Comparison if we have baseline
-	if baseline, ok := br.ConfigResults["baseline"]; ok {
-		fmt.Println("\n## Improvement vs Baseline\n")
-		for name, cr := range br.ConfigResults {
+	if _, ok := br.ConfigResults["baseline"]; ok {
+		fmt.Fprintln(w, "\n"+headingStyle.Render("## Improvement vs Baseline")+"\n")
+		for name := range br.ConfigResults {
 			if name == "baseline" {
 				continue
 			}
-			improvement := (cr.SuccessRate - baseline.SuccessRate) * 100
+			cmp := br.Compare("baseline", name)
+
 			sign := "+"
-			if improvement < 0 {
+			if cmp.Improvement < 0 {
 				sign = ""
 			}
-			fmt.Printf("  %s: %s%.1f percentage points\n", name, sign, improvement)
+			line := fmt.Sprintf("%s%.1f [%.1f, %.1f] percentage points, p=%.3f",
+				sign, cmp.Improvement, cmp.DeltaCI[0], cmp.DeltaCI[1], cmp.PValue)
+			if cmp.Significant {
+				line += " (significant)"
+			}
+			fmt.Fprintf(w, "  %s: %s\n", name, signStyle(cmp.Improvement, goodStyle, badStyle).Render(line))
 		}
 	}
 
-	fmt.Println()
+	fmt.Fprintln(w)
+}
+
+// rateStyle colors a 0-1 success rate: green at or above 70%, red below 40%,
+// and left uncolored in between.
+func rateStyle(rate float64, good, bad lipgloss.Style) lipgloss.Style {
+	switch {
+	case rate >= 0.7:
+		return good
+	case rate < 0.4:
+		return bad
+	default:
+		return lipgloss.NewStyle()
+	}
+}
+
+// signStyle colors a signed figure (e.g. an improvement delta) green when
+// non-negative and red when negative.
+func signStyle(v float64, good, bad lipgloss.Style) lipgloss.Style {
+	if v < 0 {
+		return bad
+	}
+	return good
+}
+
+// isTerminal reports whether f is connected to a terminal rather than a
+// file or pipe.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
 }
 
 func truncateName(s string, maxLen int) string {
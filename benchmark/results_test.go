@@ -0,0 +1,61 @@
+package benchmark
+
+import "testing"
+
+// TestMcNemarPValue checks mcNemarPValue against textbook values: an exact
+// binomial computation for small discordant-pair counts, and the
+// continuity-corrected chi-squared approximation once it switches over at
+// more than 25 discordant pairs.
+func TestMcNemarPValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		b, c    int
+		want    float64
+		epsilon float64
+	}{
+		{"no discordant pairs", 0, 0, 1.0, 1e-9},
+		{"perfectly balanced", 5, 5, 1.0, 1e-9},
+		// b=1,c=9: exact two-sided binomial p-value for n=10, k=min(b,c)=1.
+		{"exact binomial regime", 1, 9, 0.021484375, 1e-9},
+		// n=40 discordant pairs pushes this into the chi-squared branch.
+		{"chi-squared regime", 10, 30, 0.0026631, 1e-6},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mcNemarPValue(tc.b, tc.c)
+			if diff := got - tc.want; diff < -tc.epsilon || diff > tc.epsilon {
+				t.Errorf("mcNemarPValue(%d, %d) = %v, want %v (+/- %v)", tc.b, tc.c, got, tc.want, tc.epsilon)
+			}
+		})
+	}
+}
+
+// TestWilsonInterval checks wilsonInterval against known values, including
+// the degenerate all-success/all-failure cases where the interval is
+// asymmetric around the raw proportion.
+func TestWilsonInterval(t *testing.T) {
+	tests := []struct {
+		name             string
+		successes, total int
+		wantLo, wantHi   float64
+		epsilon          float64
+	}{
+		{"empty sample", 0, 0, 0, 0, 1e-9},
+		{"half of ten", 5, 10, 0.2366, 0.7634, 1e-3},
+		{"all successes", 10, 10, 0.7224, 1.0, 1e-3},
+		{"all failures", 0, 10, 0.0, 0.2776, 1e-3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			interval := wilsonInterval(tc.successes, tc.total)
+			if diff := interval[0] - tc.wantLo; diff < -tc.epsilon || diff > tc.epsilon {
+				t.Errorf("wilsonInterval(%d, %d)[0] = %v, want %v", tc.successes, tc.total, interval[0], tc.wantLo)
+			}
+			if diff := interval[1] - tc.wantHi; diff < -tc.epsilon || diff > tc.epsilon {
+				t.Errorf("wilsonInterval(%d, %d)[1] = %v, want %v", tc.successes, tc.total, interval[1], tc.wantHi)
+			}
+		})
+	}
+}
@@ -9,14 +9,38 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 )
 
 // Config represents a .claude configuration to test.
 type Config struct {
-	Name        string `json:"name"`        // e.g., "baseline", "golang-optimized"
-	Path        string `json:"path"`        // Path to .claude directory (empty = no config)
+	Name        string `json:"name"` // e.g., "baseline", "golang-optimized"
+	Path        string `json:"path"` // Path to .claude directory (empty = no config)
 	Description string `json:"description"`
+
+	// RunWrapper prefixes the claude invocation with a wrapper command, e.g.
+	// []string{"taskset", "-c", "0-1"} or []string{"nice", "-n", "10"}, so a
+	// config can pin, nice, or profile (perf stat) its runs per worker.
+	// Applied only to the claude binary itself; evaluation commands already
+	// go through Sandbox.
+	RunWrapper []string `json:"run_wrapper,omitempty"`
+
+	// NotSandboxed bypasses BenchmarkRunner.Sandbox for this config's claude
+	// invocation, running it directly on the host instead. Needed for
+	// configs (typically baseline) that depend on host-only tools a
+	// container image wouldn't have, at the cost of losing the isolation
+	// Sandbox provides.
+	NotSandboxed bool `json:"not_sandboxed,omitempty"`
+}
+
+// ResourceBudget caps the total CPU/memory the worker pool may consume at
+// once, on top of Parallelism's cap on worker count. It's checked against
+// Sandbox's own per-run limits when Sandbox is a *ContainerSandbox; zero
+// fields disable that dimension's check.
+type ResourceBudget struct {
+	CPUs     float64
+	MemoryMB int
 }
 
 // BenchmarkRunner executes benchmark tests.
@@ -30,8 +54,45 @@ type BenchmarkRunner struct {
 	Timeout      time.Duration // Timeout per issue
 	Verbose      bool
 
+	// Trials is how many independent times runIssue executes each (config,
+	// issue) pair. Claude's output is noisy run to run, so more than one
+	// trial lets ConfigResult.Compare/CompareTrials report a significance
+	// test instead of comparing single noisy samples. Zero or negative
+	// means 1 (the old single-run behavior).
+	Trials int
+
+	// Sandbox isolates evalTestSuite/evalCustomCheck runs from the host.
+	// Defaults to a ContainerSandbox; set to LocalSandbox{} to opt into
+	// running evaluation commands directly on the host.
+	Sandbox Sandbox
+
+	// Parallelism is the number of worker goroutines that execute runIssue
+	// concurrently. Each worker gets its own subdirectory under
+	// WorkDir/runs/worker-<id>/, so concurrent runs never share a
+	// workspace. Zero or negative means sequential (one worker).
+	Parallelism int
+
+	// MaxConcurrencyPerConfig caps how many workers may run the same Config
+	// at once, so one noisy or resource-hungry config can't skew the
+	// others. Zero means no per-config cap beyond Parallelism.
+	MaxConcurrencyPerConfig int
+
+	// Budget caps the worker pool's total CPU/memory footprint; see
+	// ResourceBudget. Zero value imposes no cap.
+	Budget ResourceBudget
+
+	// Force skips the content-addressed result cache (see cacheKey),
+	// re-running every (config, issue) pair even when a cache hit exists.
+	Force bool
+
 	// Configs to compare
 	Configs []*Config
+
+	claudeVersionOnce  sync.Once
+	claudeVersionValue string
+	claudeVersionErr   error
+
+	progressCh chan ProgressEvent
 }
 
 // NewBenchmarkRunner creates a runner with defaults.
@@ -42,10 +103,43 @@ func NewBenchmarkRunner() *BenchmarkRunner {
 		ClaudeBinary: "claude",
 		Timeout:      10 * time.Minute,
 		Verbose:      false,
+		Sandbox:      NewContainerSandbox(),
+		Parallelism:  1,
+		Trials:       1,
 		Configs:      []*Config{},
 	}
 }
 
+// effectiveParallelism returns the worker count Run should use: Parallelism,
+// clamped to at least 1 and further capped so the pool's estimated CPU/
+// memory footprint (workers × Sandbox's per-run limit) stays within Budget.
+func (r *BenchmarkRunner) effectiveParallelism() int {
+	n := r.Parallelism
+	if n < 1 {
+		n = 1
+	}
+
+	cs, ok := r.Sandbox.(*ContainerSandbox)
+	if !ok {
+		return n
+	}
+
+	if r.Budget.CPUs > 0 && cs.CPUs > 0 {
+		if byCPU := int(r.Budget.CPUs / cs.CPUs); byCPU < n {
+			n = byCPU
+		}
+	}
+	if r.Budget.MemoryMB > 0 && cs.MemoryMB > 0 {
+		if byMem := r.Budget.MemoryMB / cs.MemoryMB; byMem < n {
+			n = byMem
+		}
+	}
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
 // AddConfig adds a configuration to test.
 func (r *BenchmarkRunner) AddConfig(cfg *Config) {
 	r.Configs = append(r.Configs, cfg)
@@ -60,8 +154,64 @@ func (r *BenchmarkRunner) AddBaseline() {
 	})
 }
 
-// Run executes benchmark for all issues with all configs.
+// benchmarkJob pairs a config and issue for the worker pool, plus the
+// issue's index within the corpus so results land back in a stable slot
+// without needing to append under a lock.
+type benchmarkJob struct {
+	cfg      *Config
+	issue    *Issue
+	issueIdx int
+}
+
+// Run executes benchmark for all issues with all configs, fanning the work
+// out across a pool of Parallelism worker goroutines. Each (config, issue)
+// pair is an independent job; workers pull jobs from a shared queue and
+// write intermediate results through a serialized writer so concurrent
+// runs never corrupt the on-disk JSON. A pair whose cacheKey is already
+// present under OutputDir/cache is reused instead of re-executed, unless
+// Force is set.
 func (r *BenchmarkRunner) Run(ctx context.Context, corpus *Corpus) (*BenchmarkResult, error) {
+	return r.run(ctx, corpus, nil)
+}
+
+// Resume continues a previous run recorded at previousResultPath (a file
+// saveResult wrote). Any (config, issue) pair with a successful IssueResult
+// there is reused as-is; the rest, including pairs the previous run never
+// reached and ones that errored, are executed as normal, still subject to
+// the same content-addressed cache Run uses. This is turbo's fs-cache
+// pattern applied to a specific prior run rather than content hashes alone,
+// useful when a run crashed partway through and OutputDir/cache wasn't
+// preserved.
+func (r *BenchmarkRunner) Resume(ctx context.Context, corpus *Corpus, previousResultPath string) (*BenchmarkResult, error) {
+	data, err := os.ReadFile(previousResultPath)
+	if err != nil {
+		return nil, fmt.Errorf("read previous result: %w", err)
+	}
+
+	var previous BenchmarkResult
+	if err := json.Unmarshal(data, &previous); err != nil {
+		return nil, fmt.Errorf("parse previous result: %w", err)
+	}
+
+	seeded := make(map[string]*IssueResult)
+	for cfgName, cr := range previous.ConfigResults {
+		for _, ir := range cr.IssueResults {
+			if ir != nil && ir.Error == "" {
+				seeded[seedKey(cfgName, ir.IssueID)] = ir
+			}
+		}
+	}
+
+	return r.run(ctx, corpus, seeded)
+}
+
+// seedKey identifies a (config, issue) pair for the seeded map Resume
+// passes into run.
+func seedKey(configName, issueID string) string {
+	return configName + "\x00" + issueID
+}
+
+func (r *BenchmarkRunner) run(ctx context.Context, corpus *Corpus, seeded map[string]*IssueResult) (*BenchmarkResult, error) {
 	start := time.Now()
 
 	// Ensure directories exist
@@ -79,37 +229,104 @@ func (r *BenchmarkRunner) Run(ctx context.Context, corpus *Corpus) (*BenchmarkRe
 		ConfigResults: make(map[string]*ConfigResult),
 	}
 
-	// Run each config against all issues
+	cfgResults := make(map[string]*ConfigResult, len(r.Configs))
 	for _, cfg := range r.Configs {
-		cfgResult := &ConfigResult{
+		cfgResults[cfg.Name] = &ConfigResult{
 			ConfigName:   cfg.Name,
-			IssueResults: make([]*IssueResult, 0, len(corpus.Issues)),
+			IssueResults: make([]*IssueResult, len(corpus.Issues)),
 		}
+	}
 
-		for _, issue := range corpus.Issues {
-			if r.Verbose {
-				fmt.Printf("Running %s with config %s...\n", issue.ID, cfg.Name)
+	jobs := make(chan benchmarkJob, len(r.Configs)*len(corpus.Issues))
+	for _, cfg := range r.Configs {
+		for idx, issue := range corpus.Issues {
+			if seededResult, ok := seeded[seedKey(cfg.Name, issue.ID)]; ok {
+				cfgResults[cfg.Name].IssueResults[idx] = seededResult
+				continue
 			}
+			jobs <- benchmarkJob{cfg: cfg, issue: issue, issueIdx: idx}
+		}
+	}
+	close(jobs)
 
-			issueResult, err := r.runIssue(ctx, cfg, issue)
-			if err != nil {
-				issueResult = &IssueResult{
-					IssueID:    issue.ID,
-					ConfigName: cfg.Name,
-					Success:    false,
-					Error:      err.Error(),
+	var perConfig map[string]chan struct{}
+	if r.MaxConcurrencyPerConfig > 0 {
+		perConfig = make(map[string]chan struct{}, len(r.Configs))
+		for _, cfg := range r.Configs {
+			perConfig[cfg.Name] = make(chan struct{}, r.MaxConcurrencyPerConfig)
+		}
+	}
+
+	var writeMu sync.Mutex // serializes cfgResults writes and saveIssueResult
+	var wg sync.WaitGroup
+
+	for workerID := 0; workerID < r.effectiveParallelism(); workerID++ {
+		wg.Add(1)
+		go func(workerID int) {
+			defer wg.Done()
+			for job := range jobs {
+				if slot := perConfig[job.cfg.Name]; slot != nil {
+					slot <- struct{}{}
 				}
-			}
 
-			cfgResult.IssueResults = append(cfgResult.IssueResults, issueResult)
+				r.emitProgress(ProgressEvent{ConfigName: job.cfg.Name, IssueID: job.issue.ID, State: ProgressStarted})
 
-			// Save intermediate result
-			r.saveIssueResult(issueResult)
-		}
+				key, keyErr := r.cacheKey(job.cfg, job.issue)
+
+				var issueResult *IssueResult
+				if keyErr == nil && !r.Force {
+					if cached, ok := r.loadCachedResult(key); ok {
+						if r.Verbose {
+							fmt.Printf("[worker %d] cache hit for %s with config %s\n", workerID, job.issue.ID, job.cfg.Name)
+						}
+						issueResult = cached
+					}
+				}
+
+				if issueResult == nil {
+					if r.Verbose {
+						fmt.Printf("[worker %d] running %s with config %s...\n", workerID, job.issue.ID, job.cfg.Name)
+					}
+
+					var err error
+					issueResult, err = r.runIssue(ctx, workerID, job.cfg, job.issue)
+					if err != nil {
+						issueResult = &IssueResult{
+							IssueID:    job.issue.ID,
+							ConfigName: job.cfg.Name,
+							Success:    false,
+							Error:      err.Error(),
+						}
+					} else if keyErr == nil && issueResult.Error == "" {
+						// A non-nil error from runIssue already skips the
+						// save below; this guards the other failure path,
+						// where runIssue returns (result, nil) but the
+						// Claude CLI itself failed (rate limit, timeout,
+						// crash) and that failure landed in Error instead.
+						// Caching that would permanently replay a
+						// transient failure as if it were a real result.
+						r.saveCachedResult(key, issueResult)
+					}
+				}
+
+				writeMu.Lock()
+				cfgResults[job.cfg.Name].IssueResults[job.issueIdx] = issueResult
+				r.saveIssueResult(issueResult)
+				writeMu.Unlock()
+
+				r.emitProgress(ProgressEvent{ConfigName: job.cfg.Name, IssueID: job.issue.ID, State: ProgressDone, Result: issueResult})
 
-		// Calculate aggregate stats
-		cfgResult.calculateStats()
-		result.ConfigResults[cfg.Name] = cfgResult
+				if slot := perConfig[job.cfg.Name]; slot != nil {
+					<-slot
+				}
+			}
+		}(workerID)
+	}
+	wg.Wait()
+
+	for _, cfg := range r.Configs {
+		cfgResults[cfg.Name].calculateStats()
+		result.ConfigResults[cfg.Name] = cfgResults[cfg.Name]
 	}
 
 	result.Duration = time.Since(start)
@@ -122,84 +339,241 @@ func (r *BenchmarkRunner) Run(ctx context.Context, corpus *Corpus) (*BenchmarkRe
 	return result, nil
 }
 
-// runIssue runs a single issue with a single config.
-func (r *BenchmarkRunner) runIssue(ctx context.Context, cfg *Config, issue *Issue) (*IssueResult, error) {
-	start := time.Now()
-
-	// Create isolated workspace
-	workDir, err := r.createWorkspace(cfg.Name, issue.ID)
-	if err != nil {
-		return nil, fmt.Errorf("create workspace: %w", err)
+// Progress returns the channel run publishes ProgressEvents to, for
+// rendering a live view (e.g. the bench --interactive TUI) while a corpus
+// runs. Call it before Run/Resume so early events aren't dropped; the
+// channel is buffered, and run never blocks on a slow or absent reader.
+func (r *BenchmarkRunner) Progress() <-chan ProgressEvent {
+	if r.progressCh == nil {
+		r.progressCh = make(chan ProgressEvent, 64)
 	}
-	// Don't cleanup - keep for debugging
-	// defer os.RemoveAll(workDir)
+	return r.progressCh
+}
 
-	// Clone the repository
-	repoDir := filepath.Join(workDir, "repo")
-	if err := r.cloneRepo(ctx, issue.RepoURL, issue.RepoRef, repoDir); err != nil {
-		return nil, fmt.Errorf("clone repo: %w", err)
+// emitProgress publishes ev if anyone has called Progress(); it never
+// blocks run, dropping the event if the channel is full.
+func (r *BenchmarkRunner) emitProgress(ev ProgressEvent) {
+	if r.progressCh == nil {
+		return
 	}
-
-	// Apply .claude config if specified
-	if cfg.Path != "" {
-		if err := r.applyConfig(cfg.Path, repoDir); err != nil {
-			return nil, fmt.Errorf("apply config: %w", err)
-		}
+	select {
+	case r.progressCh <- ev:
+	default:
 	}
+}
 
-	// Build prompt with context
-	prompt := r.buildPrompt(issue, repoDir)
+// ProgressState is a ProgressEvent's point in a (config, issue) job's
+// lifecycle.
+type ProgressState int
 
-	// Run Claude
-	output, claudeErr := r.runClaude(ctx, repoDir, prompt)
+const (
+	ProgressStarted ProgressState = iota
+	ProgressDone
+)
 
-	// Evaluate result
-	evalResult := r.evaluate(ctx, issue, repoDir, output)
+// String implements fmt.Stringer.
+func (s ProgressState) String() string {
+	if s == ProgressDone {
+		return "done"
+	}
+	return "started"
+}
+
+// ProgressEvent reports one (config, issue) job's lifecycle from run.
+// Result is set only when State is ProgressDone.
+type ProgressEvent struct {
+	ConfigName string
+	IssueID    string
+	State      ProgressState
+	Result     *IssueResult
+}
+
+// runIssue runs a single issue with a single config, under the given
+// worker's isolated workspace subtree, for r.Trials independent trials
+// (defaulting to 1). Each trial gets its own fresh clone; the returned
+// IssueResult's Score/Duration/Success are the mean/mean/majority-vote
+// across Trials, with the per-trial detail kept in IssueResult.Trials.
+func (r *BenchmarkRunner) runIssue(ctx context.Context, workerID int, cfg *Config, issue *Issue) (*IssueResult, error) {
+	trials := r.Trials
+	if trials < 1 {
+		trials = 1
+	}
 
 	result := &IssueResult{
-		IssueID:      issue.ID,
-		ConfigName:   cfg.Name,
-		Difficulty:   issue.Difficulty,
-		TaskType:     issue.TaskType,
-		Language:     issue.Language,
-		Success:      evalResult.Success,
-		Score:        evalResult.Score,
-		ClaudeOutput: output,
-		EvalDetails:  evalResult.Details,
-		Duration:     time.Since(start),
-		WorkDir:      workDir,
+		IssueID:    issue.ID,
+		ConfigName: cfg.Name,
+		Difficulty: issue.Difficulty,
+		TaskType:   issue.TaskType,
+		Language:   issue.Language,
+		Trials:     make([]TrialResult, 0, trials),
 	}
 
-	if claudeErr != nil {
-		result.Error = claudeErr.Error()
+	for t := 0; t < trials; t++ {
+		trialStart := time.Now()
+
+		// Create isolated workspace
+		workDir, err := r.createWorkspace(workerID, cfg.Name, fmt.Sprintf("%s-trial%d", issue.ID, t))
+		if err != nil {
+			return nil, fmt.Errorf("create workspace: %w", err)
+		}
+		// Don't cleanup - keep for debugging
+		// defer os.RemoveAll(workDir)
+		result.WorkDir = workDir
+
+		// Clone the repository
+		repoDir := filepath.Join(workDir, "repo")
+		if err := r.cloneRepo(ctx, issue, repoDir); err != nil {
+			return nil, fmt.Errorf("clone repo: %w", err)
+		}
+
+		// Apply .claude config if specified
+		if cfg.Path != "" {
+			if err := r.applyConfig(cfg.Path, repoDir); err != nil {
+				return nil, fmt.Errorf("apply config: %w", err)
+			}
+		}
+
+		// Build prompt with context
+		prompt := r.buildPrompt(issue, repoDir)
+
+		// Run Claude
+		output, trace, claudeErr := r.runClaude(ctx, cfg, repoDir, prompt, workDir)
+
+		// Evaluate result
+		evalResult := r.evaluate(ctx, issue, repoDir, output)
+
+		// Award partial credit when the eval strategy's own score doesn't
+		// already reflect it: a run that touched roughly the right files
+		// shouldn't score a flat 0 just because the test suite or judge
+		// scored it a strict pass/fail.
+		var modifiedFiles []string
+		var fileOverlap float64
+		if len(issue.ExpectedFiles) > 0 {
+			if files, err := modifiedRepoFiles(ctx, repoDir); err == nil {
+				modifiedFiles = files
+				fileOverlap = jaccardScore(files, issue.ExpectedFiles)
+				if fileOverlap > evalResult.Score {
+					evalResult.Score = fileOverlap
+					evalResult.Details = fmt.Sprintf("%s (partial credit: %.0f%% file overlap with expected files)", evalResult.Details, fileOverlap*100)
+				}
+			}
+		}
+
+		trial := TrialResult{
+			Success:       evalResult.Success,
+			Score:         evalResult.Score,
+			Duration:      time.Since(trialStart),
+			Trace:         trace,
+			ModifiedFiles: modifiedFiles,
+			FileOverlap:   fileOverlap,
+		}
+		if claudeErr != nil {
+			trial.Error = claudeErr.Error()
+		}
+		result.Trials = append(result.Trials, trial)
+
+		// Keep the first trial's transcript for debugging, unless a later
+		// trial failed and the first one didn't (failures read better).
+		if t == 0 || (trial.Error != "" && result.Error == "") {
+			result.ClaudeOutput = output
+			result.EvalDetails = evalResult.Details
+			result.Error = trial.Error
+			result.Trace = trace
+		}
 	}
 
+	aggregateTrials(result)
 	return result, nil
 }
 
-// cloneRepo clones a git repository.
-func (r *BenchmarkRunner) cloneRepo(ctx context.Context, url, ref, dest string) error {
-	// Clone
-	cmd := exec.CommandContext(ctx, "git", "clone", "--depth", "1", url, dest)
-	if output, err := cmd.CombinedOutput(); err != nil {
-		return fmt.Errorf("git clone: %w: %s", err, output)
+// aggregateTrials fills an IssueResult's Score/Duration/Success from its
+// Trials: the mean score, the mean duration, and a majority-success vote
+// (ties count as success).
+func aggregateTrials(ir *IssueResult) {
+	n := len(ir.Trials)
+	if n == 0 {
+		return
 	}
 
-	// Checkout specific ref if provided
-	if ref != "" && ref != "main" && ref != "master" {
-		cmd = exec.CommandContext(ctx, "git", "fetch", "origin", ref)
-		cmd.Dir = dest
-		if output, err := cmd.CombinedOutput(); err != nil {
-			return fmt.Errorf("git fetch ref: %w: %s", err, output)
+	var totalScore float64
+	var totalDuration time.Duration
+	var totalInputTokens, totalOutputTokens, totalCostUSD, totalToolCalls float64
+	successes := 0
+	for _, t := range ir.Trials {
+		totalScore += t.Score
+		totalDuration += t.Duration
+		if t.Success {
+			successes++
 		}
+		if t.Trace != nil {
+			totalInputTokens += float64(t.Trace.InputTokens)
+			totalOutputTokens += float64(t.Trace.OutputTokens)
+			totalCostUSD += t.Trace.CostUSD
+			totalToolCalls += float64(len(t.Trace.ToolCalls))
+		}
+	}
+
+	ir.Score = totalScore / float64(n)
+	ir.Duration = totalDuration / time.Duration(n)
+	ir.Success = successes*2 >= n
+	ir.InputTokens = totalInputTokens / float64(n)
+	ir.OutputTokens = totalOutputTokens / float64(n)
+	ir.CostUSD = totalCostUSD / float64(n)
+	ir.ToolCallCount = totalToolCalls / float64(n)
+}
+
+// cloneRepo materializes issue's repository at dest, via the VCS provider
+// vcsFor resolves for it. Git repos (the common case) go through
+// cloneRepoGit's local worktree cache instead of calling GitVCS directly,
+// since a corpus run repeats the same repo across every config.
+func (r *BenchmarkRunner) cloneRepo(ctx context.Context, issue *Issue, dest string) error {
+	provider, err := vcsFor(issue)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := provider.(GitVCS); ok {
+		return r.cloneRepoGit(ctx, issue.RepoURL, issue.RepoRef, dest)
+	}
 
-		cmd = exec.CommandContext(ctx, "git", "checkout", ref)
-		cmd.Dir = dest
+	return provider.Materialize(ctx, issue.RepoURL, issue.RepoRef, dest)
+}
+
+// cloneRepoGit clones url once into WorkDir/vcs-cache/<repoCacheKey>, then
+// uses "git worktree add" to produce dest checked out at ref. For a corpus
+// of N issues run across M configs this turns N*M clones into N clones
+// (plus a fetch per cache hit), and leaves the cache directory reusable
+// offline across machines by copying WorkDir/vcs-cache.
+func (r *BenchmarkRunner) cloneRepoGit(ctx context.Context, url, ref, dest string) error {
+	cacheDir := filepath.Join(r.WorkDir, "vcs-cache", repoCacheKey(url))
+
+	if _, err := os.Stat(cacheDir); os.IsNotExist(err) {
+		if err := os.MkdirAll(filepath.Dir(cacheDir), 0755); err != nil {
+			return err
+		}
+		cmd := exec.CommandContext(ctx, "git", "clone", url, cacheDir)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("git clone (cache): %w: %s", err, output)
+		}
+	} else {
+		cmd := exec.CommandContext(ctx, "git", "fetch", "origin")
+		cmd.Dir = cacheDir
 		if output, err := cmd.CombinedOutput(); err != nil {
-			return fmt.Errorf("git checkout: %w: %s", err, output)
+			return fmt.Errorf("git fetch (cache): %w: %s", err, output)
 		}
 	}
 
+	checkout := ref
+	if checkout == "" {
+		checkout = "HEAD"
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "worktree", "add", "--detach", dest, checkout)
+	cmd.Dir = cacheDir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git worktree add: %w: %s", err, output)
+	}
+
 	return nil
 }
 
@@ -234,39 +608,67 @@ func (r *BenchmarkRunner) buildPrompt(issue *Issue, repoDir string) string {
 	return sb.String()
 }
 
-// runClaude executes Claude CLI.
-func (r *BenchmarkRunner) runClaude(ctx context.Context, workDir, prompt string) (string, error) {
+// runClaude executes Claude CLI with --output-format stream-json, prefixed
+// with cfg.RunWrapper if set (e.g. taskset/nice/perf stat), routed through
+// r.Sandbox unless cfg.NotSandboxed opts this config out of isolation. The
+// raw stream is persisted to traceDir/session.jsonl and parsed into a
+// SessionTrace alongside the plain assistant transcript runIssue stores as
+// IssueResult.ClaudeOutput.
+func (r *BenchmarkRunner) runClaude(ctx context.Context, cfg *Config, workDir, prompt, traceDir string) (string, *SessionTrace, error) {
 	ctx, cancel := context.WithTimeout(ctx, r.Timeout)
 	defer cancel()
 
 	args := []string{
 		"--print",
 		"--dangerously-skip-permissions",
+		"--output-format", "stream-json",
+		"--verbose",
 		prompt,
 	}
 
-	cmd := exec.CommandContext(ctx, r.ClaudeBinary, args...)
-	cmd.Dir = workDir
+	bin := r.ClaudeBinary
+	if len(cfg.RunWrapper) > 0 {
+		args = append(append([]string{}, cfg.RunWrapper[1:]...), append([]string{bin}, args...)...)
+		bin = cfg.RunWrapper[0]
+	}
+	command := append([]string{bin}, args...)
 
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	var raw string
+	var runErr error
+	if cfg.NotSandboxed {
+		cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+		cmd.Dir = workDir
 
-	err := cmd.Run()
-	output := stdout.String()
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
 
-	if err != nil {
-		return output, fmt.Errorf("claude: %w: %s", err, stderr.String())
+		if err := cmd.Run(); err != nil {
+			runErr = fmt.Errorf("claude: %w: %s", err, stderr.String())
+		}
+		raw = stdout.String()
+	} else {
+		var env []string
+		if key := os.Getenv("ANTHROPIC_API_KEY"); key != "" {
+			env = append(env, "ANTHROPIC_API_KEY="+key)
+		}
+		raw, runErr = r.Sandbox.RunClaude(ctx, workDir, command, env)
+	}
+
+	if saveErr := saveSessionTrace(traceDir, raw); saveErr != nil && r.Verbose {
+		fmt.Printf("warning: save session trace: %v\n", saveErr)
 	}
 
-	return output, nil
+	trace := parseSessionStream(raw)
+	return trace.Transcript, trace, runErr
 }
 
-// createWorkspace creates an isolated directory for a benchmark run.
-func (r *BenchmarkRunner) createWorkspace(configName, issueID string) (string, error) {
+// createWorkspace creates an isolated directory for a benchmark run, scoped
+// under this worker's own subtree so concurrent workers never share one.
+func (r *BenchmarkRunner) createWorkspace(workerID int, configName, issueID string) (string, error) {
 	timestamp := time.Now().Format("20060102-150405")
 	name := fmt.Sprintf("%s_%s_%s", timestamp, configName, issueID)
-	dir := filepath.Join(r.WorkDir, "runs", name)
+	dir := filepath.Join(r.WorkDir, "runs", fmt.Sprintf("worker-%d", workerID), name)
 
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return "", err
@@ -0,0 +1,70 @@
+package benchmark
+
+import "testing"
+
+// TestEffectiveParallelismUncapped checks that a non-ContainerSandbox (e.g.
+// LocalSandbox) leaves Parallelism untouched by the Budget check, and that
+// Parallelism itself is floored at 1.
+func TestEffectiveParallelismUncapped(t *testing.T) {
+	r := &BenchmarkRunner{Parallelism: 4, Sandbox: LocalSandbox{}}
+	if got := r.effectiveParallelism(); got != 4 {
+		t.Errorf("effectiveParallelism() = %d, want 4", got)
+	}
+
+	r = &BenchmarkRunner{Parallelism: 0, Sandbox: LocalSandbox{}}
+	if got := r.effectiveParallelism(); got != 1 {
+		t.Errorf("effectiveParallelism() with Parallelism=0 = %d, want 1 (floored)", got)
+	}
+}
+
+// TestEffectiveParallelismBudgetCapping checks that Budget.CPUs/MemoryMB
+// cap Parallelism down when running against a ContainerSandbox whose
+// per-run limits would otherwise overcommit the budget.
+func TestEffectiveParallelismBudgetCapping(t *testing.T) {
+	tests := []struct {
+		name   string
+		budget ResourceBudget
+		sbx    *ContainerSandbox
+		want   int
+	}{
+		{
+			name:   "CPU budget caps below Parallelism",
+			budget: ResourceBudget{CPUs: 4},
+			sbx:    &ContainerSandbox{CPUs: 2},
+			want:   2, // 4 CPUs / 2 per worker = 2 workers
+		},
+		{
+			name:   "memory budget caps below Parallelism",
+			budget: ResourceBudget{MemoryMB: 4096},
+			sbx:    &ContainerSandbox{MemoryMB: 2048},
+			want:   2, // 4096MB / 2048MB per worker = 2 workers
+		},
+		{
+			name:   "tighter of the two dimensions wins",
+			budget: ResourceBudget{CPUs: 8, MemoryMB: 1024},
+			sbx:    &ContainerSandbox{CPUs: 1, MemoryMB: 2048},
+			want:   1, // CPU allows 8 workers, memory allows 0 -> floored to 1
+		},
+		{
+			name:   "budget looser than Parallelism has no effect",
+			budget: ResourceBudget{CPUs: 100},
+			sbx:    &ContainerSandbox{CPUs: 1},
+			want:   3, // Parallelism itself is the binding constraint
+		},
+		{
+			name:   "zero budget fields disable that dimension's check",
+			budget: ResourceBudget{},
+			sbx:    &ContainerSandbox{CPUs: 2, MemoryMB: 2048},
+			want:   3,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &BenchmarkRunner{Parallelism: 3, Budget: tc.budget, Sandbox: tc.sbx}
+			if got := r.effectiveParallelism(); got != tc.want {
+				t.Errorf("effectiveParallelism() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package benchmark
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+)
+
+// Sandbox runs an evaluation command against repoDir, isolating it from the
+// host according to the implementation's policy. BenchmarkRunner routes
+// evalTestSuite, evalCustomCheck, and runClaude through it rather than
+// invoking exec.CommandContext directly, since benchmark issues (and their
+// check scripts) are untrusted input, and a misbehaving config shouldn't be
+// able to touch the host.
+type Sandbox interface {
+	// Run executes command with its working directory set to repoDir (or
+	// the sandbox's equivalent of it) and returns stdout/stderr separately.
+	// language selects a per-language base image where the sandbox supports
+	// one; implementations that don't care about language may ignore it.
+	Run(ctx context.Context, language, repoDir string, command []string) (stdout, stderr []byte, err error)
+
+	// RunClaude executes command (the claude binary plus its args) with its
+	// working directory set to workDir, forwarding env (e.g.
+	// "ANTHROPIC_API_KEY=...") and, where the implementation supports it,
+	// the user's own Claude credentials/settings so the CLI can
+	// authenticate. Unlike Run, it always permits network access, since the
+	// Claude CLI must reach the Anthropic API. Returns combined stdout only,
+	// matching the shape runIssue stores as IssueResult.ClaudeOutput.
+	RunClaude(ctx context.Context, workDir string, command []string, env []string) (stdout string, err error)
+}
+
+// LocalSandbox runs the command directly on the host, exactly as this
+// package did before sandboxing existed. It is opt-in: callers must set
+// BenchmarkRunner.Sandbox = LocalSandbox{} explicitly, since running
+// untrusted benchmark issues this way is unsafe.
+type LocalSandbox struct{}
+
+// Run implements Sandbox.
+func (LocalSandbox) Run(ctx context.Context, language, repoDir string, command []string) ([]byte, []byte, error) {
+	if len(command) == 0 {
+		return nil, nil, fmt.Errorf("no command specified")
+	}
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Dir = repoDir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	return stdout.Bytes(), stderr.Bytes(), err
+}
+
+// RunClaude implements Sandbox.
+func (LocalSandbox) RunClaude(ctx context.Context, workDir string, command []string, env []string) (string, error) {
+	if len(command) == 0 {
+		return "", fmt.Errorf("no command specified")
+	}
+
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Dir = workDir
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	output := stdout.String()
+	if err != nil {
+		return output, fmt.Errorf("claude: %w: %s", err, stderr.String())
+	}
+	return output, nil
+}
+
+// ContainerSandbox runs evaluation commands inside a disposable Docker or
+// Podman container: repoDir is bind-mounted read-write at /workspace,
+// networking is disabled unless AllowNetwork is set, and CPU/memory limits
+// apply per run. This is the default BenchmarkRunner.Sandbox.
+type ContainerSandbox struct {
+	// Runtime is the container CLI to invoke: "docker" or "podman".
+	// Defaults to "docker".
+	Runtime string
+
+	// Images maps an issue.Language to the base image used for its
+	// container. Languages not present fall back to DefaultImage.
+	Images map[string]string
+
+	// DefaultImage is used when Images has no entry for the issue's
+	// language. Defaults to "ubuntu:22.04".
+	DefaultImage string
+
+	// CPUs and MemoryMB limit the container's resources. Zero disables the
+	// corresponding limit.
+	CPUs     float64
+	MemoryMB int
+
+	// AllowNetwork enables container networking. Off by default, since
+	// benchmark issues are untrusted.
+	AllowNetwork bool
+
+	// ClaudeHomeDir is bind-mounted read-only into RunClaude's container at
+	// /root/.claude, so the CLI has the credentials/settings it needs to
+	// authenticate. Defaults to $HOME/.claude. This is the host's own Claude
+	// config, distinct from the benchmarked Config.Path under test (which
+	// applyConfig already copies into the repo itself).
+	ClaudeHomeDir string
+
+	// ClaudeImage is the image RunClaude runs the CLI in. Defaults to
+	// "node:20", since the claude binary is a Node package.
+	ClaudeImage string
+}
+
+// NewContainerSandbox creates a ContainerSandbox with sane per-language
+// default images and conservative resource limits.
+func NewContainerSandbox() *ContainerSandbox {
+	home, _ := os.UserHomeDir()
+	return &ContainerSandbox{
+		Runtime: "docker",
+		Images: map[string]string{
+			"go":         "golang:1.21",
+			"python":     "python:3.11",
+			"javascript": "node:20",
+			"typescript": "node:20",
+			"rust":       "rust:1.75",
+		},
+		DefaultImage:  "ubuntu:22.04",
+		CPUs:          2,
+		MemoryMB:      2048,
+		AllowNetwork:  false,
+		ClaudeHomeDir: filepath.Join(home, ".claude"),
+		ClaudeImage:   "node:20",
+	}
+}
+
+// Run implements Sandbox.
+func (s *ContainerSandbox) Run(ctx context.Context, language, repoDir string, command []string) ([]byte, []byte, error) {
+	if len(command) == 0 {
+		return nil, nil, fmt.Errorf("no command specified")
+	}
+
+	runtime := s.Runtime
+	if runtime == "" {
+		runtime = "docker"
+	}
+
+	image := s.Images[language]
+	if image == "" {
+		image = s.DefaultImage
+	}
+	if image == "" {
+		image = "ubuntu:22.04"
+	}
+
+	args := []string{
+		"run", "--rm",
+		"-v", repoDir + ":/workspace",
+		"-w", "/workspace",
+	}
+
+	if !s.AllowNetwork {
+		args = append(args, "--network", "none")
+	}
+	if s.CPUs > 0 {
+		args = append(args, "--cpus", fmt.Sprintf("%g", s.CPUs))
+	}
+	if s.MemoryMB > 0 {
+		args = append(args, "--memory", fmt.Sprintf("%dm", s.MemoryMB))
+	}
+
+	args = append(args, image)
+	args = append(args, command...)
+
+	cmd := exec.CommandContext(ctx, runtime, args...)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	return stdout.Bytes(), stderr.Bytes(), err
+}
+
+// RunClaude implements Sandbox. It always allows networking (unlike Run),
+// mounts workDir read-write at /workspace, mounts ClaudeHomeDir read-only at
+// /root/.claude so the CLI can authenticate, forwards env, applies the same
+// CPU/memory limits as Run, and stops the container if ctx is canceled
+// (docker/podman don't reliably stop a foreground container on SIGKILL to
+// the CLI client alone, so we name the container and kill it explicitly).
+func (s *ContainerSandbox) RunClaude(ctx context.Context, workDir string, command []string, env []string) (string, error) {
+	if len(command) == 0 {
+		return "", fmt.Errorf("no command specified")
+	}
+
+	runtime := s.Runtime
+	if runtime == "" {
+		runtime = "docker"
+	}
+
+	image := s.ClaudeImage
+	if image == "" {
+		image = "node:20"
+	}
+
+	name := fmt.Sprintf("claude-bench-%d", time.Now().UnixNano())
+	args := []string{
+		"run", "--rm", "--name", name,
+		"-v", workDir + ":/workspace",
+		"-w", "/workspace",
+	}
+
+	if s.ClaudeHomeDir != "" {
+		args = append(args, "-v", s.ClaudeHomeDir+":/root/.claude:ro")
+	}
+	for _, e := range env {
+		args = append(args, "-e", e)
+	}
+	if s.CPUs > 0 {
+		args = append(args, "--cpus", fmt.Sprintf("%g", s.CPUs))
+	}
+	if s.MemoryMB > 0 {
+		args = append(args, "--memory", fmt.Sprintf("%dm", s.MemoryMB))
+	}
+
+	args = append(args, image)
+	args = append(args, command...)
+
+	cmd := exec.Command(runtime, args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Start(); err != nil {
+		return "", err
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+
+	select {
+	case <-ctx.Done():
+		exec.Command(runtime, "kill", name).Run()
+		<-done
+		return stdout.String(), ctx.Err()
+	case err := <-done:
+		if err != nil {
+			return stdout.String(), fmt.Errorf("claude: %w: %s", err, stderr.String())
+		}
+		return stdout.String(), nil
+	}
+}
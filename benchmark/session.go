@@ -0,0 +1,136 @@
+package benchmark
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// SessionTrace summarizes a parsed "claude --output-format stream-json"
+// session: every tool call Claude made, the files it touched, total token
+// usage and cost, and a truncated transcript of its text output. Recorded
+// alongside a TrialResult's Score/Success so configs can be compared on
+// efficiency, not just success rate.
+type SessionTrace struct {
+	ToolCalls    []ToolCall `json:"tool_calls"`
+	FilesRead    []string   `json:"files_read,omitempty"`
+	FilesWritten []string   `json:"files_written,omitempty"`
+	InputTokens  int        `json:"input_tokens"`
+	OutputTokens int        `json:"output_tokens"`
+	CostUSD      float64    `json:"cost_usd"`
+	NumTurns     int        `json:"num_turns"`
+
+	// Transcript is Claude's assistant-text output, truncated to
+	// transcriptMaxLen so a long session doesn't bloat IssueResult JSON.
+	// The untruncated original is always available in session.jsonl.
+	Transcript string `json:"transcript"`
+}
+
+// ToolCall is one tool invocation Claude made during a session.
+// parseSessionStream reads no per-tool timing, so Duration is left zero.
+type ToolCall struct {
+	Name     string          `json:"name"`
+	Input    json.RawMessage `json:"input,omitempty"`
+	Duration time.Duration   `json:"duration,omitempty"`
+}
+
+// transcriptMaxLen caps SessionTrace.Transcript; see truncateOutput.
+const transcriptMaxLen = 4000
+
+// streamEvent is the subset of claude --output-format stream-json's event
+// schema this package cares about. Each line of the stream is one event.
+type streamEvent struct {
+	Type    string `json:"type"`
+	Message struct {
+		Content []struct {
+			Type  string          `json:"type"`
+			Text  string          `json:"text"`
+			Name  string          `json:"name"`
+			Input json.RawMessage `json:"input"`
+		} `json:"content"`
+	} `json:"message"`
+	Usage struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage"`
+	CostUSD  float64 `json:"cost_usd"`
+	NumTurns int     `json:"num_turns"`
+}
+
+// parseSessionStream parses the newline-delimited JSON output of claude
+// --output-format stream-json into a SessionTrace. Lines that aren't valid
+// JSON (stray CLI output, blank lines) are skipped rather than failing the
+// whole parse, since a single malformed line shouldn't discard an
+// otherwise-usable trace.
+func parseSessionStream(raw string) *SessionTrace {
+	trace := &SessionTrace{}
+	var transcript strings.Builder
+
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var ev streamEvent
+		if err := json.Unmarshal([]byte(line), &ev); err != nil {
+			continue
+		}
+
+		switch ev.Type {
+		case "assistant":
+			for _, block := range ev.Message.Content {
+				switch block.Type {
+				case "text":
+					transcript.WriteString(block.Text)
+				case "tool_use":
+					trace.ToolCalls = append(trace.ToolCalls, ToolCall{Name: block.Name, Input: block.Input})
+					recordFileAccess(trace, block.Name, block.Input)
+				}
+			}
+		case "result":
+			trace.InputTokens = ev.Usage.InputTokens
+			trace.OutputTokens = ev.Usage.OutputTokens
+			trace.CostUSD = ev.CostUSD
+			trace.NumTurns = ev.NumTurns
+		}
+	}
+
+	trace.Transcript = truncateOutput(transcript.String(), transcriptMaxLen)
+	return trace
+}
+
+// recordFileAccess appends the file path a Read/Write/Edit tool call
+// touched to trace.FilesRead/FilesWritten, when the tool's input has a
+// recognizable file_path argument.
+func recordFileAccess(trace *SessionTrace, toolName string, input json.RawMessage) {
+	var args struct {
+		FilePath string `json:"file_path"`
+	}
+	if err := json.Unmarshal(input, &args); err != nil || args.FilePath == "" {
+		return
+	}
+
+	switch toolName {
+	case "Read":
+		trace.FilesRead = append(trace.FilesRead, args.FilePath)
+	case "Write", "Edit":
+		trace.FilesWritten = append(trace.FilesWritten, args.FilePath)
+	}
+}
+
+// saveSessionTrace persists the raw stream-json output to
+// traceDir/session.jsonl for post-hoc analysis beyond what SessionTrace
+// summarizes.
+func saveSessionTrace(traceDir, raw string) error {
+	if traceDir == "" {
+		return nil
+	}
+	return os.WriteFile(filepath.Join(traceDir, "session.jsonl"), []byte(raw), 0644)
+}
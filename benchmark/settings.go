@@ -0,0 +1,206 @@
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// SettingsVersion is the schema version Save writes. LoadSettings tolerates a file
+// written by a newer binary: fields this version doesn't recognize are kept
+// in Extra/Profile.Extra and written back out untouched, so upgrading and
+// downgrading bench across a team doesn't lose settings.
+const SettingsVersion = 1
+
+// Profile captures one named bench invocation's full flag set - configs,
+// filters, an issue ID subset, output dir, and verbosity - so a reproducible
+// run is `--profile <name>` instead of a long shell alias.
+type Profile struct {
+	Configs    []string `json:"configs,omitempty"`
+	Difficulty string   `json:"difficulty,omitempty"`
+	TaskType   string   `json:"task_type,omitempty"`
+	Language   string   `json:"language,omitempty"`
+	IssueIDs   []string `json:"issue_ids,omitempty"`
+	OutputDir  string   `json:"output_dir,omitempty"`
+	Verbose    bool     `json:"verbose,omitempty"`
+
+	// Extra preserves fields this binary doesn't recognize so they survive
+	// a load-modify-save round trip untouched.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// profileAlias breaks MarshalJSON/UnmarshalJSON's recursion into Profile's
+// own methods below.
+type profileAlias Profile
+
+// UnmarshalJSON decodes the known fields normally and stashes anything else
+// in Extra.
+func (p *Profile) UnmarshalJSON(data []byte) error {
+	aux := (*profileAlias)(p)
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	return unmarshalExtra(data, knownProfileFields, &p.Extra)
+}
+
+// MarshalJSON writes the known fields plus any Extra this binary doesn't
+// recognize, so round-tripping a newer binary's profile doesn't drop data.
+func (p Profile) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(profileAlias(p), p.Extra)
+}
+
+var knownProfileFields = []string{
+	"configs", "difficulty", "task_type", "language", "issue_ids", "output_dir", "verbose",
+}
+
+// Settings is the on-disk shape of settings.json: a version tag plus named
+// profiles.
+type Settings struct {
+	Version  int                `json:"version"`
+	Profiles map[string]Profile `json:"profiles"`
+
+	// Extra preserves top-level fields this binary doesn't recognize.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+type settingsAlias Settings
+
+func (s *Settings) UnmarshalJSON(data []byte) error {
+	aux := (*settingsAlias)(s)
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	return unmarshalExtra(data, knownSettingsFields, &s.Extra)
+}
+
+func (s Settings) MarshalJSON() ([]byte, error) {
+	return marshalWithExtra(settingsAlias(s), s.Extra)
+}
+
+var knownSettingsFields = []string{"version", "profiles"}
+
+// unmarshalExtra re-decodes data as a raw field map and stores every key not
+// in known into *extra, so forward-compatible fields survive a round trip.
+func unmarshalExtra(data []byte, known []string, extra *map[string]json.RawMessage) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for _, k := range known {
+		delete(raw, k)
+	}
+	if len(raw) == 0 {
+		*extra = nil
+		return nil
+	}
+	*extra = raw
+	return nil
+}
+
+// marshalWithExtra marshals v (a plain alias struct, no custom MarshalJSON
+// of its own) and merges in extra's keys, skipping any that v already wrote.
+func marshalWithExtra(v any, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(extra) == 0 {
+		return data, nil
+	}
+
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	for k, v := range extra {
+		if _, exists := merged[k]; !exists {
+			merged[k] = v
+		}
+	}
+	return json.Marshal(merged)
+}
+
+// SettingsPath returns $XDG_CONFIG_HOME/claude-benchmark/settings.json via
+// os.UserConfigDir, which honors XDG_CONFIG_HOME on Linux and falls back to
+// the platform default config directory elsewhere.
+func SettingsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "claude-benchmark", "settings.json"), nil
+}
+
+// LoadSettings reads settings.json, returning an empty, current-version
+// Settings if the file doesn't exist yet.
+func LoadSettings() (*Settings, error) {
+	path, err := SettingsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Settings{Version: SettingsVersion, Profiles: map[string]Profile{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read settings: %w", err)
+	}
+
+	var s Settings
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parse settings: %w", err)
+	}
+	if s.Profiles == nil {
+		s.Profiles = map[string]Profile{}
+	}
+	return &s, nil
+}
+
+// Save writes s to settings.json, creating the parent directory if needed.
+func (s *Settings) Save() error {
+	path, err := SettingsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create settings dir: %w", err)
+	}
+
+	if s.Version == 0 {
+		s.Version = SettingsVersion
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// SaveProfile sets name's profile and persists settings.json.
+func (s *Settings) SaveProfile(name string, p Profile) error {
+	if s.Profiles == nil {
+		s.Profiles = map[string]Profile{}
+	}
+	s.Profiles[name] = p
+	return s.Save()
+}
+
+// DeleteProfile removes name's profile (a no-op if it doesn't exist) and
+// persists settings.json.
+func (s *Settings) DeleteProfile(name string) error {
+	delete(s.Profiles, name)
+	return s.Save()
+}
+
+// ProfileNames returns every saved profile name, alphabetically.
+func (s *Settings) ProfileNames() []string {
+	names := make([]string, 0, len(s.Profiles))
+	for name := range s.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
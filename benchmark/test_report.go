@@ -0,0 +1,258 @@
+package benchmark
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TestReport is a structured summary of a test run, built by decoding
+// language-specific machine-readable test output (go test -json, Jest
+// --json, pytest --junitxml) instead of grepping stdout for pass/fail
+// substrings. It lets evalHybrid and callers reason about which tests
+// failed rather than just a scalar score.
+type TestReport struct {
+	Total    int                     `json:"total"`
+	Passed   int                     `json:"passed"`
+	Failed   int                     `json:"failed"`
+	Skipped  int                     `json:"skipped"`
+	Failures []TestFailure           `json:"failures,omitempty"`
+	Packages map[string]PackageStats `json:"packages,omitempty"`
+}
+
+// TestFailure identifies one failing test and, where available, the
+// message/output that explains the failure.
+type TestFailure struct {
+	Package string `json:"package"`
+	Name    string `json:"name"`
+	Message string `json:"message,omitempty"`
+}
+
+// PackageStats is the pass/fail/skip breakdown for a single package, test
+// file, or class, depending on the language's grouping.
+type PackageStats struct {
+	Passed  int `json:"passed"`
+	Failed  int `json:"failed"`
+	Skipped int `json:"skipped"`
+}
+
+// Score returns the fraction of tests that passed, or 0 for a nil report
+// or one with no tests.
+func (r *TestReport) Score() float64 {
+	if r == nil || r.Total == 0 {
+		return 0
+	}
+	return float64(r.Passed) / float64(r.Total)
+}
+
+// parseTestReport decodes stdout into a TestReport using the parser for
+// language: go test -json for "go", jest --json for "javascript" and
+// "typescript". It returns nil for any other language (pytest's JUnit file
+// is read separately, by readJUnitReport) or if the output couldn't be
+// parsed, so callers can fall back to the heuristic parseTestScore.
+func parseTestReport(language string, stdout []byte) *TestReport {
+	switch language {
+	case "go":
+		if report, err := parseGoTestJSON(stdout); err == nil {
+			return report
+		}
+	case "javascript", "typescript":
+		if report, err := parseJestJSON(stdout); err == nil {
+			return report
+		}
+	}
+	return nil
+}
+
+// goTestEvent is one line of `go test -json` event-stream output.
+type goTestEvent struct {
+	Action  string `json:"Action"`
+	Package string `json:"Package"`
+	Test    string `json:"Test"`
+	Output  string `json:"Output"`
+}
+
+// parseGoTestJSON decodes the `go test -json` event stream into a
+// TestReport. Events without a Test field are package-level (build output,
+// overall pass/fail) and are ignored in favor of the per-test pass/fail/skip
+// events, which sum to the same totals with per-test detail.
+func parseGoTestJSON(stdout []byte) (*TestReport, error) {
+	report := &TestReport{Packages: make(map[string]PackageStats)}
+	output := make(map[string]string) // package/test -> accumulated "output" events
+
+	dec := json.NewDecoder(bytes.NewReader(stdout))
+	seen := false
+	for {
+		var ev goTestEvent
+		if err := dec.Decode(&ev); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if ev.Test == "" {
+			continue
+		}
+		seen = true
+
+		switch ev.Action {
+		case "output":
+			output[ev.Package+"/"+ev.Test] += ev.Output
+
+		case "pass":
+			report.Total++
+			report.Passed++
+			stats := report.Packages[ev.Package]
+			stats.Passed++
+			report.Packages[ev.Package] = stats
+
+		case "fail":
+			report.Total++
+			report.Failed++
+			stats := report.Packages[ev.Package]
+			stats.Failed++
+			report.Packages[ev.Package] = stats
+			report.Failures = append(report.Failures, TestFailure{
+				Package: ev.Package,
+				Name:    ev.Test,
+				Message: truncateOutput(strings.TrimSpace(output[ev.Package+"/"+ev.Test]), 500),
+			})
+
+		case "skip":
+			report.Total++
+			report.Skipped++
+			stats := report.Packages[ev.Package]
+			stats.Skipped++
+			report.Packages[ev.Package] = stats
+		}
+	}
+
+	if !seen {
+		return nil, fmt.Errorf("no go test -json events found")
+	}
+	return report, nil
+}
+
+// jestJSONReport matches the subset of `jest --json` output needed to build
+// a TestReport.
+type jestJSONReport struct {
+	NumTotalTests   int `json:"numTotalTests"`
+	NumPassedTests  int `json:"numPassedTests"`
+	NumFailedTests  int `json:"numFailedTests"`
+	NumPendingTests int `json:"numPendingTests"`
+	TestResults     []struct {
+		Name             string `json:"name"`
+		AssertionResults []struct {
+			FullName        string   `json:"fullName"`
+			Status          string   `json:"status"`
+			FailureMessages []string `json:"failureMessages"`
+		} `json:"assertionResults"`
+	} `json:"testResults"`
+}
+
+// parseJestJSON decodes `jest --json` (or a Mocha JSON reporter configured
+// to emit the same shape) into a TestReport.
+func parseJestJSON(stdout []byte) (*TestReport, error) {
+	var jr jestJSONReport
+	if err := json.Unmarshal(stdout, &jr); err != nil {
+		return nil, err
+	}
+	if jr.NumTotalTests == 0 && len(jr.TestResults) == 0 {
+		return nil, fmt.Errorf("no jest results found")
+	}
+
+	report := &TestReport{
+		Total:    jr.NumTotalTests,
+		Passed:   jr.NumPassedTests,
+		Failed:   jr.NumFailedTests,
+		Skipped:  jr.NumPendingTests,
+		Packages: make(map[string]PackageStats),
+	}
+
+	for _, file := range jr.TestResults {
+		stats := report.Packages[file.Name]
+		for _, a := range file.AssertionResults {
+			switch a.Status {
+			case "passed":
+				stats.Passed++
+			case "failed":
+				stats.Failed++
+				report.Failures = append(report.Failures, TestFailure{
+					Package: file.Name,
+					Name:    a.FullName,
+					Message: truncateOutput(strings.Join(a.FailureMessages, "\n"), 500),
+				})
+			case "pending", "skipped":
+				stats.Skipped++
+			}
+		}
+		report.Packages[file.Name] = stats
+	}
+
+	return report, nil
+}
+
+// junitTestSuite is the subset of JUnit XML (as emitted by `pytest
+// --junitxml`) needed to build a TestReport.
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *junitFailure `xml:"failure"`
+	Skipped   *struct{}     `xml:"skipped"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// parseJUnitXML decodes a pytest --junitxml report into a TestReport.
+func parseJUnitXML(data []byte) (*TestReport, error) {
+	var suite junitTestSuite
+	if err := xml.Unmarshal(data, &suite); err != nil {
+		return nil, err
+	}
+
+	report := &TestReport{
+		Total:    suite.Tests,
+		Failed:   suite.Failures,
+		Skipped:  suite.Skipped,
+		Packages: make(map[string]PackageStats),
+	}
+	report.Passed = report.Total - report.Failed - report.Skipped
+
+	for _, tc := range suite.TestCases {
+		stats := report.Packages[tc.ClassName]
+		switch {
+		case tc.Failure != nil:
+			stats.Failed++
+			msg := tc.Failure.Message
+			if msg == "" {
+				msg = strings.TrimSpace(tc.Failure.Text)
+			}
+			report.Failures = append(report.Failures, TestFailure{
+				Package: tc.ClassName,
+				Name:    tc.Name,
+				Message: truncateOutput(msg, 500),
+			})
+		case tc.Skipped != nil:
+			stats.Skipped++
+		default:
+			stats.Passed++
+		}
+		report.Packages[tc.ClassName] = stats
+	}
+
+	return report, nil
+}
@@ -0,0 +1,204 @@
+package benchmark
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// VCS materializes an issue's repository at a specific ref into dest.
+// Providers are dispatched by Issue.VCS when set, or by RepoURL's scheme
+// otherwise (see vcsFor). BenchmarkRunner.cloneRepo uses this for every
+// provider except git, which it routes through its own worktree cache
+// instead (see cloneRepoGit).
+type VCS interface {
+	// Materialize checks out ref from url into dest, creating dest. ref may
+	// be empty, in which case the provider uses its default revision.
+	Materialize(ctx context.Context, url, ref, dest string) error
+}
+
+// vcsProviders maps a VCS name to its implementation, shared by vcsFor and
+// any caller that wants a specific provider via Issue.VCS.
+var vcsProviders = map[string]VCS{
+	"git":     GitVCS{},
+	"hg":      HgVCS{},
+	"local":   LocalVCS{},
+	"tarball": TarballVCS{},
+}
+
+// vcsFor resolves the VCS provider for issue: its explicit VCS field when
+// set, or a guess from RepoURL's scheme/suffix otherwise.
+func vcsFor(issue *Issue) (VCS, error) {
+	if issue.VCS != "" {
+		provider, ok := vcsProviders[issue.VCS]
+		if !ok {
+			return nil, fmt.Errorf("unknown vcs %q", issue.VCS)
+		}
+		return provider, nil
+	}
+
+	switch {
+	case strings.HasSuffix(issue.RepoURL, ".tar.gz") || strings.HasSuffix(issue.RepoURL, ".tgz"):
+		return vcsProviders["tarball"], nil
+	case strings.HasPrefix(issue.RepoURL, "file://") || !strings.Contains(issue.RepoURL, "://"):
+		return vcsProviders["local"], nil
+	default:
+		return vcsProviders["git"], nil
+	}
+}
+
+// GitVCS clones a git repository directly: a shallow (--depth 1) clone, plus
+// a fetch and checkout of ref unless ref is empty, "main" or "master". BenchmarkRunner.cloneRepo doesn't call this for its own runs (it
+// uses cloneRepoGit's cached-worktree path instead); this exists so GitVCS
+// satisfies VCS for any other caller, and as the explicit provider for
+// Issue.VCS == "git".
+type GitVCS struct{}
+
+// Materialize implements VCS.
+func (GitVCS) Materialize(ctx context.Context, url, ref, dest string) error {
+	cmd := exec.CommandContext(ctx, "git", "clone", "--depth", "1", url, dest)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git clone: %w: %s", err, output)
+	}
+
+	if ref != "" && ref != "main" && ref != "master" {
+		cmd = exec.CommandContext(ctx, "git", "fetch", "origin", ref)
+		cmd.Dir = dest
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("git fetch ref: %w: %s", err, output)
+		}
+
+		cmd = exec.CommandContext(ctx, "git", "checkout", ref)
+		cmd.Dir = dest
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("git checkout: %w: %s", err, output)
+		}
+	}
+
+	return nil
+}
+
+// HgVCS clones a Mercurial repository and updates to ref.
+type HgVCS struct{}
+
+// Materialize implements VCS.
+func (HgVCS) Materialize(ctx context.Context, url, ref, dest string) error {
+	cmd := exec.CommandContext(ctx, "hg", "clone", url, dest)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("hg clone: %w: %s", err, output)
+	}
+
+	if ref != "" {
+		cmd = exec.CommandContext(ctx, "hg", "update", "-r", ref)
+		cmd.Dir = dest
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("hg update: %w: %s", err, output)
+		}
+	}
+
+	return nil
+}
+
+// LocalVCS materializes a local fixture directory by rsync-copying it, for
+// issues that point at a repo already on disk (e.g. unit test fixtures)
+// rather than something to clone. ref is ignored; a local fixture has no
+// revisions to select between. url may be a bare path or a file:// URL.
+type LocalVCS struct{}
+
+// Materialize implements VCS.
+func (LocalVCS) Materialize(ctx context.Context, url, _, dest string) error {
+	src := strings.TrimPrefix(url, "file://")
+
+	cmd := exec.CommandContext(ctx, "rsync", "-a", src+"/", dest+"/")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("rsync: %w: %s", err, output)
+	}
+	return nil
+}
+
+// TarballVCS fetches a .tar.gz/.tgz archive over HTTP(S) and extracts it
+// into dest. ref is ignored; a tarball has no revisions to select between.
+type TarballVCS struct{}
+
+// Materialize implements VCS.
+func (TarballVCS) Materialize(ctx context.Context, url, _, dest string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("fetch tarball: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch tarball: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch tarball: unexpected status %s", resp.Status)
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("gunzip tarball: %w", err)
+	}
+	defer gz.Close()
+
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return err
+	}
+
+	cleanDest := filepath.Clean(dest)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read tarball: %w", err)
+		}
+
+		target := filepath.Join(dest, hdr.Name)
+		if target != cleanDest && !strings.HasPrefix(target, cleanDest+string(os.PathSeparator)) {
+			return fmt.Errorf("tarball entry %q escapes dest", hdr.Name)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode))
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				f.Close()
+				return err
+			}
+			f.Close()
+		}
+	}
+
+	return nil
+}
+
+// repoCacheKey derives cloneRepoGit's cache subdirectory name from a repo
+// URL, so the same repo across many issues/configs shares one cache entry.
+func repoCacheKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:])[:16]
+}
@@ -0,0 +1,68 @@
+package benchmark
+
+import "testing"
+
+// TestIncompleteBeta checks incompleteBeta against closed-form values: the
+// symmetric Beta(0.5, 0.5) distribution is exactly 0.5 at its midpoint, and
+// Beta(2, 3)'s CDF has the closed form 1-(1-x)^3(1+3x) for integer
+// parameters.
+func TestIncompleteBeta(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b, x float64
+		want    float64
+		epsilon float64
+	}{
+		{"symmetric midpoint", 0.5, 0.5, 0.5, 0.5, 1e-9},
+		{"beta(2,3) at 0.3", 2, 3, 0.3, 0.3483, 1e-4},
+		{"x at lower bound", 1, 1, 0, 0, 1e-9},
+		{"x at upper bound", 1, 1, 1, 1, 1e-9},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := incompleteBeta(tc.a, tc.b, tc.x)
+			if diff := got - tc.want; diff < -tc.epsilon || diff > tc.epsilon {
+				t.Errorf("incompleteBeta(%v, %v, %v) = %v, want %v", tc.a, tc.b, tc.x, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestWelchTTest checks welchTTest's edge cases and its behavior on
+// textbook-shaped samples: identical samples give no evidence of a
+// difference, and two samples with no overlap at all are overwhelmingly
+// significant.
+func TestWelchTTest(t *testing.T) {
+	if got := welchTTest([]float64{1}, []float64{1, 2}); got != 1.0 {
+		t.Errorf("welchTTest with an under-sized sample = %v, want 1.0", got)
+	}
+
+	if got := welchTTest([]float64{10, 10, 10, 10}, []float64{10, 10, 10, 10}); got != 1.0 {
+		t.Errorf("welchTTest on identical zero-variance samples = %v, want 1.0", got)
+	}
+
+	p := welchTTest([]float64{1, 2, 3, 4, 5}, []float64{100, 101, 102, 103, 104})
+	if p >= 0.001 {
+		t.Errorf("welchTTest on clearly separated samples = %v, want a small p-value", p)
+	}
+}
+
+// TestMeanStdDev checks meanStdDev's sample mean/Bessel-corrected stddev
+// against a hand-computed value, plus its 0- and 1-sample edge cases.
+func TestMeanStdDev(t *testing.T) {
+	if mean, sd := meanStdDev(nil); mean != 0 || sd != 0 {
+		t.Errorf("meanStdDev(nil) = (%v, %v), want (0, 0)", mean, sd)
+	}
+	if mean, sd := meanStdDev([]float64{5}); mean != 5 || sd != 0 {
+		t.Errorf("meanStdDev of a single sample = (%v, %v), want (5, 0)", mean, sd)
+	}
+
+	mean, sd := meanStdDev([]float64{1, 2, 3, 4, 5})
+	if mean != 3 {
+		t.Errorf("meanStdDev mean = %v, want 3", mean)
+	}
+	if diff := sd - 1.5811388300841898; diff < -1e-9 || diff > 1e-9 {
+		t.Errorf("meanStdDev stddev = %v, want 1.5811388300841898", sd)
+	}
+}
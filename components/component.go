@@ -0,0 +1,59 @@
+// Package components promotes the bubbletea-tui skill's ad-hoc
+// ComponentModel scaffold (skills/bubbletea-tui/scaffolds/component.go)
+// into a real, importable subsystem: a Component interface every widget
+// implements, plus a Container that composes them into multi-pane TUIs
+// with focus routing and message bubbling. It follows the same
+// dependency-injected-value approach the theme package adopted over its
+// own scaffold predecessor - a generated program imports this package
+// directly rather than copy-pasting a template file.
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Component is the shape every widget in this package implements. Update
+// returns the widget's own concrete type (wrapped back into Component),
+// not tea.Model - the same "returns own type" pattern the bubbletea-tui
+// scaffold already documents, so a Container can type-assert back down
+// when a specific widget's extra methods are needed.
+type Component interface {
+	Init() tea.Cmd
+	Update(tea.Msg) (Component, tea.Cmd)
+	View() string
+
+	// Focus and Blur toggle whether the component processes input. A
+	// blurred component still renders (typically in a dimmed style) but
+	// ignores key messages.
+	Focus() tea.Cmd
+	Blur()
+	Focused() bool
+
+	// SetSize tells the component the exact width/height it should
+	// render into. Container calls this on every child whenever its own
+	// size changes or its layout recomputes child slots.
+	SetSize(width, height int)
+
+	// KeyMap returns the bindings this component currently responds to,
+	// so a Container can add the focused child's bindings to its own and
+	// show them in one help.Model view via RegisterKeyBindings.
+	KeyMap() []key.Binding
+}
+
+// ComponentMsg is the envelope a child uses to emit an application event
+// up through its parent Container, the same role ComponentSelectedMsg
+// plays in the bubbletea-tui scaffold but generalized to any payload.
+// Container.Update re-emits these as a tea.Cmd so the top-level Model's
+// own Update sees them untouched - "bubble" rather than "broadcast".
+type ComponentMsg struct {
+	Source string // the Container child ID that emitted it, see Container.Add
+	Msg    tea.Msg
+}
+
+// FocusChangedMsg signals that Container's focused child changed, so a
+// sibling that cares (e.g. a StatusBar showing "which pane is active")
+// can react without the parent Model wiring that logic itself.
+type FocusChangedMsg struct {
+	ID string
+}
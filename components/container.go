@@ -0,0 +1,258 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Layout is how Container arranges its children's rectangles within its
+// own SetSize bounds.
+type Layout int
+
+const (
+	// LayoutStack renders only the focused child, full-size - the shape a
+	// Modal or a wizard's single-step-at-a-time view needs.
+	LayoutStack Layout = iota
+	// LayoutRow splits width evenly across children, left to right.
+	LayoutRow
+	// LayoutColumn splits height evenly across children, top to bottom.
+	LayoutColumn
+)
+
+// child pairs a Component with the ID callers address it by.
+type child struct {
+	id   string
+	comp Component
+}
+
+// nextFocusKey and prevFocusKey are Container's built-in tab-navigation
+// bindings; a caller that wants different keys sets Container.TabKey /
+// Container.ShiftTabKey before the first Update.
+var (
+	nextFocusKey = key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "next pane"))
+	prevFocusKey = key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", "prev pane"))
+)
+
+// Container composes child Components into a single Component, routing
+// key input to whichever child currently has focus and bubbling any
+// ComponentMsg a child emits back up through its own Update return value
+// rather than swallowing it.
+type Container struct {
+	children []child
+	focused  int // index into children, -1 if none
+
+	layout Layout
+	width  int
+	height int
+
+	TabKey      key.Binding
+	ShiftTabKey key.Binding
+}
+
+// NewContainer creates an empty Container with the given Layout. Add
+// children with Add before the first Update/View.
+func NewContainer(layout Layout) *Container {
+	return &Container{
+		layout:      layout,
+		focused:     -1,
+		TabKey:      nextFocusKey,
+		ShiftTabKey: prevFocusKey,
+	}
+}
+
+// Add appends a child under id, used as ComponentMsg.Source and as the
+// focus target for SetFocus. The first child added becomes the selected
+// child; Add does not call its Focus, Container.Focus does.
+func (c *Container) Add(id string, comp Component) *Container {
+	c.children = append(c.children, child{id: id, comp: comp})
+	if c.focused == -1 {
+		c.focused = 0
+	}
+	c.layoutChildren()
+	return c
+}
+
+// SetFocus moves focus to the child registered under id, blurring the
+// previously focused child. It's a no-op if id isn't registered.
+func (c *Container) SetFocus(id string) tea.Cmd {
+	for i, ch := range c.children {
+		if ch.id == id {
+			return c.focusIndex(i)
+		}
+	}
+	return nil
+}
+
+func (c *Container) focusIndex(i int) tea.Cmd {
+	if i == c.focused || i < 0 || i >= len(c.children) {
+		return nil
+	}
+	if c.focused >= 0 {
+		c.children[c.focused].comp.Blur()
+	}
+	c.focused = i
+	cmd := c.children[c.focused].comp.Focus()
+	id := c.children[c.focused].id
+	return tea.Batch(cmd, func() tea.Msg { return FocusChangedMsg{ID: id} })
+}
+
+// layoutChildren recomputes every child's SetSize from c.layout and the
+// Container's own dimensions, so Add and SetSize both funnel through one
+// place instead of duplicating the split math.
+func (c *Container) layoutChildren() {
+	n := len(c.children)
+	if n == 0 || c.width == 0 || c.height == 0 {
+		return
+	}
+
+	switch c.layout {
+	case LayoutStack:
+		for _, ch := range c.children {
+			ch.comp.SetSize(c.width, c.height)
+		}
+	case LayoutRow:
+		w := c.width / n
+		for i, ch := range c.children {
+			cw := w
+			if i == n-1 {
+				cw = c.width - w*(n-1) // give the remainder to the last pane
+			}
+			ch.comp.SetSize(cw, c.height)
+		}
+	case LayoutColumn:
+		h := c.height / n
+		for i, ch := range c.children {
+			ch2 := h
+			if i == n-1 {
+				ch2 = c.height - h*(n-1)
+			}
+			ch.comp.SetSize(c.width, ch2)
+		}
+	}
+}
+
+// Init implements Component, initializing every child.
+func (c *Container) Init() tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(c.children))
+	for _, ch := range c.children {
+		cmds = append(cmds, ch.comp.Init())
+	}
+	return tea.Batch(cmds...)
+}
+
+// Update implements Component: tab/shift+tab move focus, everything else
+// goes to the focused child, and any ComponentMsg a child's Update
+// returns is passed along untouched so the top-level Model's Update still
+// sees it (bubble, not broadcast - only the focused child ever sees a
+// tea.KeyMsg, but every child sees non-key messages so e.g. a window
+// resize or a tick reaches all of them).
+func (c *Container) Update(msg tea.Msg) (Component, tea.Cmd) {
+	switch m := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(m, c.TabKey) {
+			return c, c.focusIndex((c.focused + 1) % len(c.children))
+		}
+		if key.Matches(m, c.ShiftTabKey) {
+			return c, c.focusIndex((c.focused - 1 + len(c.children)) % len(c.children))
+		}
+		if c.focused < 0 {
+			return c, nil
+		}
+		updated, cmd := c.children[c.focused].comp.Update(msg)
+		c.children[c.focused].comp = updated
+		return c, cmd
+	default:
+		var cmds []tea.Cmd
+		for i, ch := range c.children {
+			updated, cmd := ch.comp.Update(msg)
+			c.children[i].comp = updated
+			cmds = append(cmds, cmd)
+		}
+		return c, tea.Batch(cmds...)
+	}
+}
+
+// View implements Component, rendering only the focused child under
+// LayoutStack or every child side by side under LayoutRow/LayoutColumn.
+func (c *Container) View() string {
+	if c.layout == LayoutStack {
+		if c.focused < 0 {
+			return ""
+		}
+		return c.children[c.focused].comp.View()
+	}
+
+	views := make([]string, len(c.children))
+	for i, ch := range c.children {
+		views[i] = ch.comp.View()
+	}
+	if c.layout == LayoutRow {
+		return lipgloss.JoinHorizontal(lipgloss.Top, views...)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, views...)
+}
+
+// Focus focuses the Container's currently selected child.
+func (c *Container) Focus() tea.Cmd {
+	if c.focused < 0 {
+		return nil
+	}
+	return c.children[c.focused].comp.Focus()
+}
+
+// Blur blurs the Container's currently selected child, leaving the
+// selection itself unchanged so a later Focus resumes the same pane.
+func (c *Container) Blur() {
+	if c.focused >= 0 {
+		c.children[c.focused].comp.Blur()
+	}
+}
+
+// Focused reports whether the Container has a focused child.
+func (c *Container) Focused() bool {
+	return c.focused >= 0 && c.children[c.focused].comp.Focused()
+}
+
+// SetSize implements Component, recomputing every child's rectangle.
+func (c *Container) SetSize(width, height int) {
+	c.width, c.height = width, height
+	c.layoutChildren()
+}
+
+// KeyMap returns the Container's own tab/shift+tab bindings plus the
+// focused child's, mirroring which keys Update actually honors.
+func (c *Container) KeyMap() []key.Binding {
+	bindings := []key.Binding{c.TabKey, c.ShiftTabKey}
+	if c.focused >= 0 {
+		bindings = append(bindings, c.children[c.focused].comp.KeyMap()...)
+	}
+	return bindings
+}
+
+// RegisterKeyBindings renders a single help.Model view summarizing every
+// binding c.KeyMap() returns, so a parent Model's footer doesn't need to
+// know which child is focused to show the right shortcuts.
+func RegisterKeyBindings(c Component, full bool) string {
+	h := help.New()
+	km := containerKeyMap{bindings: c.KeyMap()}
+	if full {
+		return h.FullHelpView(km.FullHelp())
+	}
+	return h.ShortHelpView(km.ShortHelp())
+}
+
+// containerKeyMap adapts a flat []key.Binding to bubbles/help's KeyMap
+// interface, which wants ShortHelp/FullHelp accessors rather than a slice.
+type containerKeyMap struct {
+	bindings []key.Binding
+}
+
+func (k containerKeyMap) ShortHelp() []key.Binding {
+	return k.bindings
+}
+
+func (k containerKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.bindings}
+}
@@ -0,0 +1,116 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	formNextFieldKey = key.NewBinding(key.WithKeys("tab", "enter"), key.WithHelp("tab/enter", "next field"))
+	formSubmitKey    = key.NewBinding(key.WithKeys("ctrl+s"), key.WithHelp("ctrl+s", "submit"))
+)
+
+// FormSubmittedMsg carries every field's final value, keyed by the label
+// passed to Form.AddField, when formSubmitKey fires on any field.
+type FormSubmittedMsg struct {
+	Values map[string]string
+}
+
+// formLabelStyle is the style Form renders each field's label in.
+var formLabelStyle = lipgloss.NewStyle().Bold(true)
+
+// field pairs a Form input with the label FormSubmittedMsg.Values keys it
+// under.
+type field struct {
+	label string
+	input textinput.Model
+}
+
+// Form is a sequential set of single-line text inputs: tab/enter moves to
+// the next field, wrapping back to the first, and ctrl+s emits
+// FormSubmittedMsg with every field's current value.
+type Form struct {
+	fields  []field
+	active  int
+	width   int
+	focused bool
+}
+
+// NewForm creates an empty Form; add fields with AddField before the
+// first Update/View.
+func NewForm() *Form {
+	return &Form{}
+}
+
+// AddField appends a text input under label.
+func (f *Form) AddField(label, placeholder string) *Form {
+	ti := textinput.New()
+	ti.Placeholder = placeholder
+	f.fields = append(f.fields, field{label: label, input: ti})
+	return f
+}
+
+func (f *Form) Init() tea.Cmd { return nil }
+
+func (f *Form) Update(msg tea.Msg) (Component, tea.Cmd) {
+	if !f.focused || len(f.fields) == 0 {
+		return f, nil
+	}
+
+	if km, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(km, formSubmitKey):
+			values := make(map[string]string, len(f.fields))
+			for _, fl := range f.fields {
+				values[fl.label] = fl.input.Value()
+			}
+			return f, func() tea.Msg { return FormSubmittedMsg{Values: values} }
+		case key.Matches(km, formNextFieldKey):
+			f.fields[f.active].input.Blur()
+			f.active = (f.active + 1) % len(f.fields)
+			return f, f.fields[f.active].input.Focus()
+		}
+	}
+
+	var cmd tea.Cmd
+	f.fields[f.active].input, cmd = f.fields[f.active].input.Update(msg)
+	return f, cmd
+}
+
+func (f *Form) View() string {
+	lines := make([]string, 0, len(f.fields)*2)
+	for _, fl := range f.fields {
+		lines = append(lines, formLabelStyle.Render(fl.label), fl.input.View())
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func (f *Form) Focus() tea.Cmd {
+	f.focused = true
+	if len(f.fields) == 0 {
+		return nil
+	}
+	return f.fields[f.active].input.Focus()
+}
+
+func (f *Form) Blur() {
+	f.focused = false
+	for i := range f.fields {
+		f.fields[i].input.Blur()
+	}
+}
+
+func (f *Form) Focused() bool { return f.focused }
+
+func (f *Form) SetSize(width, height int) {
+	f.width = width
+	for i := range f.fields {
+		f.fields[i].input.Width = width
+	}
+}
+
+func (f *Form) KeyMap() []key.Binding {
+	return []key.Binding{formNextFieldKey, formSubmitKey}
+}
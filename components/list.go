@@ -0,0 +1,117 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// listKeys are List's own bindings, reported through KeyMap so a
+// Container aggregating help text doesn't have to special-case it.
+var listKeys = struct {
+	Select key.Binding
+}{
+	Select: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select")),
+}
+
+// ListItemSelectedMsg is emitted when Select fires on the highlighted
+// item - the Component-subsystem equivalent of the bubbletea-tui
+// scaffold's ComponentSelectedMsg.
+type ListItemSelectedMsg struct {
+	Index int
+	Title string
+}
+
+// List wraps bubbles/list.Model as a Component: it owns SetSize/Focus
+// bookkeeping and translates bubbles/list's own messages into
+// ListItemSelectedMsg on enter.
+type List struct {
+	model   list.Model
+	focused bool
+}
+
+// NewList creates a List over items, already delegate-rendered by
+// bubbles/list's DefaultDelegate.
+func NewList(title string, items []list.Item) *List {
+	m := list.New(items, list.NewDefaultDelegate(), 0, 0)
+	m.Title = title
+	return &List{model: m}
+}
+
+func (l *List) Init() tea.Cmd { return nil }
+
+func (l *List) Update(msg tea.Msg) (Component, tea.Cmd) {
+	if !l.focused {
+		return l, nil
+	}
+
+	if km, ok := msg.(tea.KeyMsg); ok && key.Matches(km, listKeys.Select) {
+		if item, ok := l.model.SelectedItem().(interface{ Title() string }); ok {
+			idx := l.model.Index()
+			return l, func() tea.Msg {
+				return ListItemSelectedMsg{Index: idx, Title: item.Title()}
+			}
+		}
+	}
+
+	var cmd tea.Cmd
+	l.model, cmd = l.model.Update(msg)
+	return l, cmd
+}
+
+func (l *List) View() string {
+	return l.model.View()
+}
+
+func (l *List) Focus() tea.Cmd { l.focused = true; return nil }
+func (l *List) Blur()          { l.focused = false }
+func (l *List) Focused() bool  { return l.focused }
+
+func (l *List) SetSize(width, height int) {
+	l.model.SetSize(width, height)
+}
+
+func (l *List) KeyMap() []key.Binding {
+	return []key.Binding{l.model.KeyMap.CursorUp, l.model.KeyMap.CursorDown, listKeys.Select}
+}
+
+// statusBarStyle is the default StatusBar rendering; override
+// StatusBar.Style for a different look.
+var statusBarStyle = lipgloss.NewStyle().Padding(0, 1)
+
+// StatusBar is a single-line, non-focusable Component showing arbitrary
+// text (e.g. "which pane is active", rendered from a FocusChangedMsg a
+// parent Container emits).
+type StatusBar struct {
+	Style lipgloss.Style
+	text  string
+	width int
+}
+
+// NewStatusBar creates a StatusBar with the default Style.
+func NewStatusBar() *StatusBar {
+	return &StatusBar{Style: statusBarStyle}
+}
+
+// SetText replaces the StatusBar's rendered text.
+func (s *StatusBar) SetText(text string) { s.text = text }
+
+func (s *StatusBar) Init() tea.Cmd { return nil }
+
+func (s *StatusBar) Update(msg tea.Msg) (Component, tea.Cmd) {
+	if fc, ok := msg.(FocusChangedMsg); ok {
+		s.SetText("Active: " + fc.ID)
+	}
+	return s, nil
+}
+
+func (s *StatusBar) View() string {
+	return s.Style.Width(s.width).Render(s.text)
+}
+
+func (s *StatusBar) Focus() tea.Cmd        { return nil }
+func (s *StatusBar) Blur()                 {}
+func (s *StatusBar) Focused() bool         { return false }
+func (s *StatusBar) SetSize(w, h int)      { s.width = w }
+func (s *StatusBar) KeyMap() []key.Binding { return nil }
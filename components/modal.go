@@ -0,0 +1,65 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var modalCloseKey = key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "close"))
+
+// ModalClosedMsg is emitted when Modal's close key fires, so the parent
+// Model can drop focus back to whatever was focused before the modal
+// opened. Container itself does not handle it.
+type ModalClosedMsg struct{}
+
+// modalStyle is Modal's default border; override Modal.Style for a
+// different look.
+var modalStyle = lipgloss.NewStyle().
+	Border(lipgloss.RoundedBorder()).
+	Padding(1, 2)
+
+// Modal wraps a single child Component as an overlay: it's rendered under
+// LayoutStack-style full focus, and esc emits ModalClosedMsg rather than
+// closing itself - Container has no notion of removing a child, so the
+// parent Model's own Update is expected to react to ModalClosedMsg by
+// swapping the Modal out of whatever it's nested in.
+type Modal struct {
+	Style lipgloss.Style
+	child Component
+}
+
+// NewModal wraps child in a Modal using the default Style.
+func NewModal(child Component) *Modal {
+	return &Modal{Style: modalStyle, child: child}
+}
+
+func (m *Modal) Init() tea.Cmd { return m.child.Init() }
+
+func (m *Modal) Update(msg tea.Msg) (Component, tea.Cmd) {
+	if km, ok := msg.(tea.KeyMsg); ok && key.Matches(km, modalCloseKey) {
+		return m, func() tea.Msg { return ModalClosedMsg{} }
+	}
+	updated, cmd := m.child.Update(msg)
+	m.child = updated
+	return m, cmd
+}
+
+func (m *Modal) View() string {
+	return m.Style.Render(m.child.View())
+}
+
+func (m *Modal) Focus() tea.Cmd { return m.child.Focus() }
+func (m *Modal) Blur()          { m.child.Blur() }
+func (m *Modal) Focused() bool  { return m.child.Focused() }
+
+func (m *Modal) SetSize(width, height int) {
+	// Leave room for Style's border/padding so the child doesn't
+	// overflow the frame Modal draws around it.
+	frameW, frameH := m.Style.GetFrameSize()
+	m.child.SetSize(width-frameW, height-frameH)
+}
+
+func (m *Modal) KeyMap() []key.Binding {
+	return append([]key.Binding{modalCloseKey}, m.child.KeyMap()...)
+}
@@ -0,0 +1,76 @@
+package components
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var tableSelectKey = key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "select row"))
+
+// TableRowSelectedMsg is emitted when tableSelectKey fires on the
+// highlighted row.
+type TableRowSelectedMsg struct {
+	Row table.Row
+}
+
+// Table wraps bubbles/table.Model as a Component.
+type Table struct {
+	model   table.Model
+	focused bool
+}
+
+// NewTable creates a Table over cols/rows, matching the constructor shape
+// bubbles/table.New itself uses.
+func NewTable(cols []table.Column, rows []table.Row) *Table {
+	return &Table{model: table.New(table.WithColumns(cols), table.WithRows(rows))}
+}
+
+func (t *Table) Init() tea.Cmd { return nil }
+
+func (t *Table) Update(msg tea.Msg) (Component, tea.Cmd) {
+	if !t.focused {
+		return t, nil
+	}
+
+	if km, ok := msg.(tea.KeyMsg); ok && key.Matches(km, tableSelectKey) {
+		row := t.model.SelectedRow()
+		return t, func() tea.Msg { return TableRowSelectedMsg{Row: row} }
+	}
+
+	var cmd tea.Cmd
+	t.model, cmd = t.model.Update(msg)
+	return t, cmd
+}
+
+func (t *Table) View() string { return t.model.View() }
+
+func (t *Table) Focus() tea.Cmd {
+	t.focused = true
+	t.model.Focus()
+	return nil
+}
+
+func (t *Table) Blur() {
+	t.focused = false
+	t.model.Blur()
+}
+
+func (t *Table) Focused() bool { return t.focused }
+
+func (t *Table) SetSize(width, height int) {
+	t.model.SetWidth(width)
+	t.model.SetHeight(height)
+}
+
+// tableNavKeys mirrors bubbles/table's built-in cursor bindings. They're
+// restated here rather than read off t.model because table.Model doesn't
+// expose its internal KeyMap the way list.Model does.
+var tableNavKeys = []key.Binding{
+	key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+}
+
+func (t *Table) KeyMap() []key.Binding {
+	return append(append([]key.Binding{}, tableNavKeys...), tableSelectKey)
+}
@@ -0,0 +1,126 @@
+package components
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	tabNextKey = key.NewBinding(key.WithKeys("right", "l"), key.WithHelp("→/l", "next tab"))
+	tabPrevKey = key.NewBinding(key.WithKeys("left", "h"), key.WithHelp("←/h", "prev tab"))
+)
+
+var (
+	tabStyle       = lipgloss.NewStyle().Padding(0, 2)
+	tabActiveStyle = tabStyle.Bold(true).Underline(true)
+)
+
+// tab pairs a Tabs entry's label with its Component.
+type tab struct {
+	label string
+	comp  Component
+}
+
+// Tabs renders a row of labels above the active tab's Component, switching
+// between them with tabNextKey/tabPrevKey rather than Container's
+// tab/shift+tab (which would collide with moving focus between sibling
+// panes when a Tabs is itself nested in a Container).
+type Tabs struct {
+	tabs    []tab
+	active  int
+	width   int
+	height  int
+	focused bool
+}
+
+// NewTabs creates a Tabs with no entries; add them with Add.
+func NewTabs() *Tabs {
+	return &Tabs{}
+}
+
+// Add appends a tab under label.
+func (t *Tabs) Add(label string, comp Component) *Tabs {
+	t.tabs = append(t.tabs, tab{label: label, comp: comp})
+	return t
+}
+
+func (t *Tabs) Init() tea.Cmd {
+	if len(t.tabs) == 0 {
+		return nil
+	}
+	return t.tabs[t.active].comp.Init()
+}
+
+func (t *Tabs) Update(msg tea.Msg) (Component, tea.Cmd) {
+	if !t.focused || len(t.tabs) == 0 {
+		return t, nil
+	}
+
+	if km, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(km, tabNextKey):
+			t.active = (t.active + 1) % len(t.tabs)
+			return t, nil
+		case key.Matches(km, tabPrevKey):
+			t.active = (t.active - 1 + len(t.tabs)) % len(t.tabs)
+			return t, nil
+		}
+	}
+
+	updated, cmd := t.tabs[t.active].comp.Update(msg)
+	t.tabs[t.active].comp = updated
+	return t, cmd
+}
+
+func (t *Tabs) View() string {
+	if len(t.tabs) == 0 {
+		return ""
+	}
+
+	labels := make([]string, len(t.tabs))
+	for i, tb := range t.tabs {
+		style := tabStyle
+		if i == t.active {
+			style = tabActiveStyle
+		}
+		labels[i] = style.Render(tb.label)
+	}
+
+	bar := strings.Join(labels, "")
+	return lipgloss.JoinVertical(lipgloss.Left, bar, t.tabs[t.active].comp.View())
+}
+
+func (t *Tabs) Focus() tea.Cmd {
+	t.focused = true
+	if len(t.tabs) == 0 {
+		return nil
+	}
+	return t.tabs[t.active].comp.Focus()
+}
+
+func (t *Tabs) Blur() {
+	t.focused = false
+	if len(t.tabs) > 0 {
+		t.tabs[t.active].comp.Blur()
+	}
+}
+
+func (t *Tabs) Focused() bool { return t.focused }
+
+func (t *Tabs) SetSize(width, height int) {
+	t.width, t.height = width, height
+	for _, tb := range t.tabs {
+		tb.comp.SetSize(width, height-1) // -1 for the tab bar row
+	}
+}
+
+func (t *Tabs) KeyMap() []key.Binding {
+	bindings := []key.Binding{tabNextKey, tabPrevKey}
+	if len(t.tabs) > 0 {
+		bindings = append(bindings, t.tabs[t.active].comp.KeyMap()...)
+	}
+	return bindings
+}
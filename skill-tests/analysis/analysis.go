@@ -0,0 +1,227 @@
+// Package analysis implements a small go/analysis-style static checker for
+// SKILL.md files: their YAML frontmatter, the rule files they reference,
+// and the scaffold files they ship. It lets skill authors catch structural
+// bugs before ever paying for an API call.
+package analysis
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Severity classifies a Diagnostic's importance. Validators that gate on
+// static cleanliness (see skilltests.StaticallyClean) only fail on
+// SeverityError; SeverityWarning is surfaced but non-blocking.
+type Severity int
+
+const (
+	SeverityWarning Severity = iota
+	SeverityError
+)
+
+// String implements fmt.Stringer.
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// Diagnostic is one finding from an Analyzer. Line and Column are 1-based
+// offsets into SKILL.md, so findings can be surfaced as SARIF regions or
+// compiler-style "file:line:col: message" text.
+type Diagnostic struct {
+	Analyzer string
+	Severity Severity
+	Message  string
+	Line     int
+	Column   int
+}
+
+// Analyzer is one static check, modeled on golang.org/x/tools/go/analysis.
+// Run inspects Pass and reports findings both via its return value and via
+// Pass.Report, so callers can use whichever is convenient: Lint only
+// collects via Report, but an Analyzer's Run is independently testable
+// through its return value.
+type Analyzer struct {
+	Name string
+	Doc  string
+	Run  func(*Pass) ([]Diagnostic, error)
+}
+
+// HookConfig is one entry of a SKILL.md frontmatter's "hooks" list.
+type HookConfig struct {
+	Event   string `yaml:"event"`
+	Matcher string `yaml:"matcher"`
+	Command string `yaml:"command"`
+}
+
+// Frontmatter is a SKILL.md's YAML frontmatter block.
+type Frontmatter struct {
+	Name        string       `yaml:"name"`
+	Description string       `yaml:"description"`
+	Hooks       []HookConfig `yaml:"hooks,omitempty"`
+	MCP         string       `yaml:"mcp,omitempty"` // path, relative to the skill dir, to an MCP tool-schema JSON file
+}
+
+// Pass carries one skill directory's parsed state to every Analyzer.
+type Pass struct {
+	SkillDir string
+
+	HasFrontmatter bool // false when SKILL.md is missing or has no "---" frontmatter block
+	Frontmatter    Frontmatter
+	// FrontmatterNode is the decoded frontmatter's root mapping node, kept
+	// around so analyzers can recover line/column positions that Frontmatter
+	// itself loses on decode. Nil when HasFrontmatter is false.
+	FrontmatterNode *yaml.Node
+	Body            string // SKILL.md content after the frontmatter block
+
+	RuleFiles     []string // paths under rules/, relative to SkillDir
+	ScaffoldFiles []string // paths under scaffolds/, relative to SkillDir
+
+	// Report records a Diagnostic. Analyzers should call it for every
+	// finding; Lint supplies the implementation.
+	Report func(Diagnostic)
+}
+
+// Lint loads skillDir's SKILL.md, rules/, and scaffolds/ into a Pass and
+// runs each analyzer against it, returning every diagnostic reported.
+func Lint(skillDir string, analyzers ...*Analyzer) ([]Diagnostic, error) {
+	pass, err := loadPass(skillDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var diags []Diagnostic
+	pass.Report = func(d Diagnostic) { diags = append(diags, d) }
+
+	for _, a := range analyzers {
+		if _, err := a.Run(pass); err != nil {
+			return diags, fmt.Errorf("%s: %w", a.Name, err)
+		}
+	}
+
+	for i := range diags {
+		if diags[i].Analyzer == "" {
+			diags[i].Analyzer = "unknown"
+		}
+	}
+
+	return diags, nil
+}
+
+func loadPass(skillDir string) (*Pass, error) {
+	pass := &Pass{SkillDir: skillDir}
+
+	data, err := os.ReadFile(filepath.Join(skillDir, "SKILL.md"))
+	switch {
+	case os.IsNotExist(err):
+		return pass, nil
+	case err != nil:
+		return nil, fmt.Errorf("read SKILL.md: %w", err)
+	}
+
+	header, body, ok := splitFrontmatter(string(data))
+	pass.Body = body
+	if !ok {
+		return pass, nil
+	}
+
+	// The header starts on SKILL.md's second line, after the opening "---";
+	// the leading newline makes node positions line up with SKILL.md.
+	var node yaml.Node
+	if err := yaml.Unmarshal([]byte("\n"+header), &node); err != nil {
+		return pass, nil
+	}
+	if len(node.Content) == 0 {
+		return pass, nil
+	}
+
+	mapping := node.Content[0]
+	if err := mapping.Decode(&pass.Frontmatter); err != nil {
+		return pass, nil
+	}
+
+	pass.HasFrontmatter = true
+	pass.FrontmatterNode = mapping
+
+	pass.RuleFiles, err = listFiles(filepath.Join(skillDir, "rules"))
+	if err != nil {
+		return nil, err
+	}
+	pass.ScaffoldFiles, err = listFiles(filepath.Join(skillDir, "scaffolds"))
+	if err != nil {
+		return nil, err
+	}
+
+	return pass, nil
+}
+
+// splitFrontmatter separates a "---\n<yaml>\n---\n<body>" document into its
+// YAML header and body. ok is false when content has no frontmatter block,
+// in which case body is the entire content unchanged.
+func splitFrontmatter(content string) (header, body string, ok bool) {
+	const delim = "---"
+
+	trimmed := strings.TrimPrefix(content, delim)
+	if len(trimmed) == len(content) {
+		return "", content, false
+	}
+	trimmed = strings.TrimPrefix(strings.TrimPrefix(trimmed, "\r\n"), "\n")
+
+	idx := strings.Index(trimmed, "\n"+delim)
+	if idx < 0 {
+		return "", content, false
+	}
+
+	header = trimmed[:idx]
+	body = trimmed[idx+1+len(delim):]
+	body = strings.TrimPrefix(strings.TrimPrefix(body, "\r\n"), "\n")
+	return header, body, true
+}
+
+// listFiles returns every regular file under dir, relative to dir, using
+// forward slashes. It returns an empty slice (not an error) when dir
+// doesn't exist, since a skill without rules/ or scaffolds/ is normal.
+func listFiles(dir string) ([]string, error) {
+	var files []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == dir {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return files, nil
+}
+
+// mappingValue returns the value node for key in mapping, or nil if
+// mapping is nil or has no such key.
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	if mapping == nil {
+		return nil
+	}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultAnalyzers is the built-in analyzer set Lint runs when a caller
+// doesn't pick specific ones, e.g. skilltests.StaticallyClean.
+var DefaultAnalyzers = []*Analyzer{
+	MissingFrontmatter,
+	UnreferencedRule,
+	DeadScaffold,
+	HookMatcherUnknown,
+	MCPSchemaInvalid,
+}
+
+// MissingFrontmatter flags a SKILL.md with no frontmatter block, or one
+// missing the "name"/"description" fields every skill needs.
+var MissingFrontmatter = &Analyzer{
+	Name: "missingfrontmatter",
+	Doc:  "reports SKILL.md files with no frontmatter, or missing required fields",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		var diags []Diagnostic
+		report := func(d Diagnostic) {
+			d.Analyzer = "missingfrontmatter"
+			diags = append(diags, d)
+			pass.Report(d)
+		}
+
+		if !pass.HasFrontmatter {
+			report(Diagnostic{
+				Severity: SeverityError,
+				Message:  "SKILL.md is missing or has no \"---\" frontmatter block",
+				Line:     1,
+				Column:   1,
+			})
+			return diags, nil
+		}
+
+		if pass.Frontmatter.Name == "" {
+			line, col := fieldPos(pass.FrontmatterNode, "name")
+			report(Diagnostic{Severity: SeverityError, Message: "frontmatter missing required field \"name\"", Line: line, Column: col})
+		}
+		if pass.Frontmatter.Description == "" {
+			line, col := fieldPos(pass.FrontmatterNode, "description")
+			report(Diagnostic{Severity: SeverityError, Message: "frontmatter missing required field \"description\"", Line: line, Column: col})
+		}
+
+		return diags, nil
+	},
+}
+
+// UnreferencedRule flags a file under rules/ that SKILL.md's body never
+// mentions, a sign the rule was orphaned by a refactor.
+var UnreferencedRule = &Analyzer{
+	Name: "unreferencedrule",
+	Doc:  "reports rules/ files that SKILL.md never references",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		var diags []Diagnostic
+		for _, rel := range pass.RuleFiles {
+			if strings.Contains(pass.Body, rel) || strings.Contains(pass.Body, filepath.Base(rel)) {
+				continue
+			}
+			d := Diagnostic{
+				Analyzer: "unreferencedrule",
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("rule file %q is never referenced from SKILL.md", rel),
+				Line:     1,
+				Column:   1,
+			}
+			diags = append(diags, d)
+			pass.Report(d)
+		}
+		return diags, nil
+	},
+}
+
+// DeadScaffold flags a file under scaffolds/ that SKILL.md's body never
+// mentions, so it can't actually be pointed to during a skill run.
+var DeadScaffold = &Analyzer{
+	Name: "deadscaffold",
+	Doc:  "reports scaffolds/ files that SKILL.md never references",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		var diags []Diagnostic
+		for _, rel := range pass.ScaffoldFiles {
+			if strings.Contains(pass.Body, rel) || strings.Contains(pass.Body, filepath.Base(rel)) {
+				continue
+			}
+			d := Diagnostic{
+				Analyzer: "deadscaffold",
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("scaffold file %q is never referenced from SKILL.md", rel),
+				Line:     1,
+				Column:   1,
+			}
+			diags = append(diags, d)
+			pass.Report(d)
+		}
+		return diags, nil
+	},
+}
+
+// knownHookMatchers lists the tool names a PreToolUse/PostToolUse hook can
+// actually match against.
+var knownHookMatchers = map[string]bool{
+	"*": true, "Read": true, "Write": true, "Edit": true, "Bash": true,
+	"Glob": true, "Grep": true, "WebFetch": true, "WebSearch": true, "Task": true,
+}
+
+// HookMatcherUnknown flags a frontmatter hook whose matcher doesn't name a
+// real tool, which would silently never fire.
+var HookMatcherUnknown = &Analyzer{
+	Name: "hookmatcherunknown",
+	Doc:  "reports hooks whose matcher doesn't name a known tool",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		if !pass.HasFrontmatter {
+			return nil, nil
+		}
+
+		hooksNode := mappingValue(pass.FrontmatterNode, "hooks")
+		var diags []Diagnostic
+		for i, h := range pass.Frontmatter.Hooks {
+			if knownHookMatchers[h.Matcher] {
+				continue
+			}
+
+			line, col := 1, 1
+			if hooksNode != nil && i < len(hooksNode.Content) {
+				if m := mappingValue(hooksNode.Content[i], "matcher"); m != nil {
+					line, col = m.Line, m.Column
+				} else {
+					line, col = hooksNode.Content[i].Line, hooksNode.Content[i].Column
+				}
+			}
+
+			d := Diagnostic{
+				Analyzer: "hookmatcherunknown",
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("hook references unknown matcher %q", h.Matcher),
+				Line:     line,
+				Column:   col,
+			}
+			diags = append(diags, d)
+			pass.Report(d)
+		}
+		return diags, nil
+	},
+}
+
+// MCPSchemaInvalid flags a frontmatter "mcp" field that doesn't point at a
+// readable, valid JSON schema file.
+var MCPSchemaInvalid = &Analyzer{
+	Name: "mcpschemainvalid",
+	Doc:  "reports an mcp frontmatter field whose schema file is missing or not valid JSON",
+	Run: func(pass *Pass) ([]Diagnostic, error) {
+		if !pass.HasFrontmatter || pass.Frontmatter.MCP == "" {
+			return nil, nil
+		}
+
+		line, col := fieldPos(pass.FrontmatterNode, "mcp")
+		report := func(msg string) []Diagnostic {
+			d := Diagnostic{Analyzer: "mcpschemainvalid", Severity: SeverityError, Message: msg, Line: line, Column: col}
+			pass.Report(d)
+			return []Diagnostic{d}
+		}
+
+		data, err := os.ReadFile(filepath.Join(pass.SkillDir, pass.Frontmatter.MCP))
+		if err != nil {
+			return report(fmt.Sprintf("mcp schema %q: %v", pass.Frontmatter.MCP, err)), nil
+		}
+
+		var schema map[string]interface{}
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return report(fmt.Sprintf("mcp schema %q is not valid JSON: %v", pass.Frontmatter.MCP, err)), nil
+		}
+
+		return nil, nil
+	},
+}
+
+// fieldPos returns the line/column of key's value in mapping, or (1, 1)
+// when mapping is nil or has no such key (e.g. the field is absent
+// entirely, which is exactly when callers need a position to report at).
+func fieldPos(mapping *yaml.Node, key string) (int, int) {
+	if v := mappingValue(mapping, key); v != nil {
+		return v.Line, v.Column
+	}
+	return 1, 1
+}
@@ -0,0 +1,495 @@
+package skilltests
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/creack/pty"
+)
+
+// Attr is a bitmask of the SGR text attributes a Cell can carry.
+type Attr int
+
+const (
+	AttrBold Attr = 1 << iota
+	AttrFaint
+	AttrItalic
+	AttrUnderline
+	AttrReverse
+)
+
+// Color is an SGR color: either unset (the terminal's default), a
+// 256-color palette index (Code, 0-15 for the classic 8/bright-8 codes),
+// or a 24-bit TrueColor triple.
+type Color struct {
+	Set       bool
+	TrueColor bool
+	Code      int
+	R, G, B   uint8
+}
+
+// Cell is one character position in a rendered frame, with the fg/bg
+// color and attributes in effect when it was written - the same model
+// fzf's ansi.go uses to interpret SGR codes cell by cell.
+type Cell struct {
+	Rune rune
+	FG   Color
+	BG   Color
+	Attr Attr
+}
+
+// Screen is a parsed terminal frame: one []Cell per line, in source
+// order. Lines are not padded to a common width.
+type Screen struct {
+	Rows [][]Cell
+}
+
+// At returns the Cell at (row, col), or the zero Cell if out of bounds.
+func (s *Screen) At(row, col int) Cell {
+	if row < 0 || row >= len(s.Rows) || col < 0 || col >= len(s.Rows[row]) {
+		return Cell{}
+	}
+	return s.Rows[row][col]
+}
+
+// TextAt returns the n runes starting at (row, col), stopping early at the
+// end of the row.
+func (s *Screen) TextAt(row, col, n int) string {
+	if row < 0 || row >= len(s.Rows) {
+		return ""
+	}
+	var b strings.Builder
+	for i := 0; i < n && col+i < len(s.Rows[row]); i++ {
+		b.WriteRune(s.Rows[row][col+i].Rune)
+	}
+	return b.String()
+}
+
+// Rect is an axis-aligned region of a Screen, row/col of its top-left
+// corner plus its size.
+type Rect struct {
+	Row, Col      int
+	Width, Height int
+}
+
+// Intersects reports whether r and o overlap.
+func (r Rect) Intersects(o Rect) bool {
+	return r.Col < o.Col+o.Width && o.Col < r.Col+r.Width &&
+		r.Row < o.Row+o.Height && o.Row < r.Row+r.Height
+}
+
+var (
+	topLeftRunes     = []rune{'┌', '╭'}
+	topRightRunes    = []rune{'┐', '╮'}
+	bottomLeftRunes  = []rune{'└', '╰'}
+	bottomRightRunes = []rune{'┘', '╯'}
+)
+
+func runeIn(r rune, set []rune) bool {
+	for _, c := range set {
+		if r == c {
+			return true
+		}
+	}
+	return false
+}
+
+// BorderRects finds every rectangle outlined by box-drawing runes (the
+// square or rounded corners lipgloss.NormalBorder/RoundedBorder use) by
+// scanning for a top-left corner, then walking its top and left edges to
+// find the matching top-right and bottom-left corners.
+func (s *Screen) BorderRects() []Rect {
+	var rects []Rect
+	for r, row := range s.Rows {
+		for c, cell := range row {
+			if !runeIn(cell.Rune, topLeftRunes) {
+				continue
+			}
+
+			width := 1
+			for c+width < len(row) && row[c+width].Rune == '─' {
+				width++
+			}
+			if c+width >= len(row) || !runeIn(row[c+width].Rune, topRightRunes) {
+				continue
+			}
+			width++
+
+			height := 1
+			for r+height < len(s.Rows) && c < len(s.Rows[r+height]) && s.Rows[r+height][c].Rune == '│' {
+				height++
+			}
+			if r+height >= len(s.Rows) || c >= len(s.Rows[r+height]) || !runeIn(s.Rows[r+height][c].Rune, bottomLeftRunes) {
+				continue
+			}
+			height++
+
+			rects = append(rects, Rect{Row: r, Col: c, Width: width, Height: height})
+		}
+	}
+	return rects
+}
+
+// HasBorder reports whether BorderRects detected exactly rect.
+func (s *Screen) HasBorder(rect Rect) bool {
+	for _, r := range s.BorderRects() {
+		if r == rect {
+			return true
+		}
+	}
+	return false
+}
+
+// DetectOverlap reports whether the detected widget borders are free of
+// overlap: it returns false, with a message naming the first intersecting
+// pair, when any two intersect, and true otherwise. This is a heuristic over the final frame alone - it can only see
+// rectangles outlined in box-drawing runes, not which component wrote
+// each cell, so a collision between two non-bordered widgets (e.g. two
+// plain text blocks with no blank line between them) won't be caught.
+func (s *Screen) DetectOverlap() (bool, string) {
+	rects := s.BorderRects()
+	for i := 0; i < len(rects); i++ {
+		for j := i + 1; j < len(rects); j++ {
+			if rects[i].Intersects(rects[j]) {
+				return false, fmt.Sprintf("widgets at %+v and %+v overlap", rects[i], rects[j])
+			}
+		}
+	}
+	return true, "no overlapping widget borders detected"
+}
+
+// sgrPattern matches one SGR ("Select Graphic Rendition") escape sequence,
+// e.g. "\x1b[1;38;5;214m". Other CSI sequences (cursor movement, clears)
+// are left as cursorControlPattern to strip and otherwise ignore - a
+// static lipgloss-rendered frame doesn't reposition the cursor mid-line.
+var (
+	sgrPattern           = regexp.MustCompile("\x1b\\[([0-9;]*)m")
+	cursorControlPattern = regexp.MustCompile("\x1b\\[[0-9;]*[A-GJKHfST]")
+)
+
+type cellState struct {
+	fg, bg Color
+	attr   Attr
+}
+
+// ParseScreen interprets raw (a captured terminal frame, one line per
+// "\n") into a Screen, tracking SGR color/attribute state across each
+// line's runes the way a real terminal emulator would.
+func ParseScreen(raw string) *Screen {
+	lines := strings.Split(raw, "\n")
+	screen := &Screen{Rows: make([][]Cell, len(lines))}
+	for i, line := range lines {
+		screen.Rows[i] = parseLine(line)
+	}
+	return screen
+}
+
+func parseLine(line string) []Cell {
+	line = cursorControlPattern.ReplaceAllString(line, "")
+
+	var cells []Cell
+	var state cellState
+	idx := 0
+	for idx < len(line) {
+		loc := sgrPattern.FindStringSubmatchIndex(line[idx:])
+		if loc == nil {
+			for _, r := range line[idx:] {
+				cells = append(cells, Cell{Rune: r, FG: state.fg, BG: state.bg, Attr: state.attr})
+			}
+			break
+		}
+
+		for _, r := range line[idx : idx+loc[0]] {
+			cells = append(cells, Cell{Rune: r, FG: state.fg, BG: state.bg, Attr: state.attr})
+		}
+
+		codes := parseSGRCodes(line[idx+loc[2] : idx+loc[3]])
+		applySGR(&state, codes)
+		idx += loc[1]
+	}
+	return cells
+}
+
+func parseSGRCodes(param string) []int {
+	if param == "" {
+		return []int{0}
+	}
+	parts := strings.Split(param, ";")
+	codes := make([]int, 0, len(parts))
+	for _, p := range parts {
+		if p == "" {
+			codes = append(codes, 0)
+			continue
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			continue
+		}
+		codes = append(codes, n)
+	}
+	return codes
+}
+
+func applySGR(state *cellState, codes []int) {
+	for i := 0; i < len(codes); i++ {
+		switch code := codes[i]; {
+		case code == 0:
+			*state = cellState{}
+		case code == 1:
+			state.attr |= AttrBold
+		case code == 2:
+			state.attr |= AttrFaint
+		case code == 3:
+			state.attr |= AttrItalic
+		case code == 4:
+			state.attr |= AttrUnderline
+		case code == 7:
+			state.attr |= AttrReverse
+		case code == 22:
+			state.attr &^= AttrBold | AttrFaint
+		case code == 23:
+			state.attr &^= AttrItalic
+		case code == 24:
+			state.attr &^= AttrUnderline
+		case code == 27:
+			state.attr &^= AttrReverse
+		case code >= 30 && code <= 37:
+			state.fg = Color{Set: true, Code: code - 30}
+		case code == 38:
+			c, consumed := parseExtendedColor(codes[i+1:])
+			state.fg = c
+			i += consumed
+		case code == 39:
+			state.fg = Color{}
+		case code >= 40 && code <= 47:
+			state.bg = Color{Set: true, Code: code - 40}
+		case code == 48:
+			c, consumed := parseExtendedColor(codes[i+1:])
+			state.bg = c
+			i += consumed
+		case code == 49:
+			state.bg = Color{}
+		case code >= 90 && code <= 97:
+			state.fg = Color{Set: true, Code: code - 90 + 8}
+		case code >= 100 && code <= 107:
+			state.bg = Color{Set: true, Code: code - 100 + 8}
+		}
+	}
+}
+
+// parseExtendedColor handles the "38;5;N" (256-color) and "38;2;R;G;B"
+// (TrueColor) forms, returning how many further codes it consumed so the
+// caller's loop can skip past them.
+func parseExtendedColor(rest []int) (Color, int) {
+	if len(rest) == 0 {
+		return Color{}, 0
+	}
+	switch rest[0] {
+	case 5:
+		if len(rest) >= 2 {
+			return Color{Set: true, Code: rest[1]}, 2
+		}
+	case 2:
+		if len(rest) >= 4 {
+			return Color{Set: true, TrueColor: true, R: uint8(rest[1]), G: uint8(rest[2]), B: uint8(rest[3])}, 4
+		}
+	}
+	return Color{}, 0
+}
+
+// goFencePattern extracts the first ```go fenced code block from a
+// skill's response, the same shape ContainsCode checks for.
+var goFencePattern = regexp.MustCompile("(?s)```go\\n(.*?)```")
+
+func extractGoSource(output string) (string, bool) {
+	m := goFencePattern.FindStringSubmatch(output)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
+
+// defaultANSICols, defaultANSIRows and defaultANSITimeout size and bound
+// the headless pty RenderedScreen runs generated programs in. They're
+// package constants rather than per-validator options because every
+// ANSIValidator in this file renders at the same terminal size.
+const (
+	defaultANSICols    = 80
+	defaultANSIRows    = 24
+	defaultANSITimeout = 5 * time.Second
+)
+
+// RenderedScreen extracts the ```go code block from output, runs it under
+// a headless pty (creack/pty, sized cols x rows) as `go run` would, and
+// parses whatever it prints in its final frame into a Screen. It's the
+// shared entry point every ANSI validator in this file uses; each of them
+// calls it on its own, so a case with several ANSI validators compiles and
+// runs the program once per validator.
+func RenderedScreen(output string, cols, rows int, timeout time.Duration) (*Screen, error) {
+	src, ok := extractGoSource(output)
+	if !ok {
+		return nil, fmt.Errorf("no ```go code block found in output")
+	}
+
+	dir, err := os.MkdirTemp("", "ansivalidator-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating scratch dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	mainPath := dir + "/main.go"
+	if err := os.WriteFile(mainPath, []byte(src), 0o644); err != nil {
+		return nil, fmt.Errorf("writing extracted source: %w", err)
+	}
+
+	cmd := exec.Command("go", "run", mainPath)
+	ptmx, err := pty.StartWithSize(cmd, &pty.Winsize{Rows: uint16(rows), Cols: uint16(cols)})
+	if err != nil {
+		return nil, fmt.Errorf("starting headless pty: %w", err)
+	}
+	defer ptmx.Close()
+
+	frame := readFinalFrame(ptmx, timeout)
+	_ = cmd.Process.Kill()
+	return ParseScreen(frame), nil
+}
+
+// readFinalFrame reads everything the pty produces until it closes or
+// timeout elapses, then returns only what was written after the last
+// full-screen clear - Bubble Tea (in alt-screen mode) redraws by clearing
+// and repainting, so the text after the last clear is the last complete
+// frame rather than a scroll of every frame the program ever drew.
+func readFinalFrame(ptmx *os.File, timeout time.Duration) string {
+	deadline := time.Now().Add(timeout)
+	_ = ptmx.SetReadDeadline(deadline)
+
+	var buf bytes.Buffer
+	chunk := make([]byte, 4096)
+	for time.Now().Before(deadline) {
+		n, err := ptmx.Read(chunk)
+		if n > 0 {
+			buf.Write(chunk[:n])
+		}
+		if err != nil {
+			break
+		}
+	}
+
+	out := buf.String()
+	if idx := strings.LastIndex(out, "\x1b[2J"); idx >= 0 {
+		return out[idx+len("\x1b[2J"):]
+	}
+	if idx := strings.LastIndex(out, "\x1b[H"); idx >= 0 {
+		return out[idx+len("\x1b[H"):]
+	}
+	return out
+}
+
+// RendersText checks that the generated program's final frame has s
+// starting at (row, col).
+func RendersText(row, col int, s string) Validator {
+	return func(output string, _ *TestResult) Validation {
+		name := fmt.Sprintf("ansi: renders %q at (%d,%d)", truncate(s, 20), row, col)
+		screen, err := RenderedScreen(output, defaultANSICols, defaultANSIRows, defaultANSITimeout)
+		if err != nil {
+			return Validation{Name: name, Message: fmt.Sprintf("rendering frame: %v", err)}
+		}
+		got := screen.TextAt(row, col, len([]rune(s)))
+		passed := got == s
+		return Validation{Name: name, Passed: passed, Score: boolToScore(passed), Message: fmt.Sprintf("expected %q, got %q", s, got)}
+	}
+}
+
+// HasBorderAt checks that the generated program's final frame outlines
+// rect with box-drawing runes.
+func HasBorderAt(rect Rect) Validator {
+	return func(output string, _ *TestResult) Validation {
+		name := fmt.Sprintf("ansi: border at %+v", rect)
+		screen, err := RenderedScreen(output, defaultANSICols, defaultANSIRows, defaultANSITimeout)
+		if err != nil {
+			return Validation{Name: name, Message: fmt.Sprintf("rendering frame: %v", err)}
+		}
+		ok := screen.HasBorder(rect)
+		return Validation{Name: name, Passed: ok, Score: boolToScore(ok), Message: fmt.Sprintf("border detected: %v", ok)}
+	}
+}
+
+// HasAnyBorder checks that the generated program's final frame contains
+// at least one rectangle outlined in box-drawing runes - weaker than
+// HasBorderAt, for cases whose exact border position/size isn't known up
+// front (the prompt doesn't pin down layout, only that styling was
+// applied).
+func HasAnyBorder() Validator {
+	return func(output string, _ *TestResult) Validation {
+		name := "ansi: renders a border"
+		screen, err := RenderedScreen(output, defaultANSICols, defaultANSIRows, defaultANSITimeout)
+		if err != nil {
+			return Validation{Name: name, Message: fmt.Sprintf("rendering frame: %v", err)}
+		}
+		ok := len(screen.BorderRects()) > 0
+		return Validation{Name: name, Passed: ok, Score: boolToScore(ok), Message: fmt.Sprintf("border rectangles found: %v", ok)}
+	}
+}
+
+// HasStyledForeground checks that at least one cell in the generated
+// program's final frame has a non-default foreground color, i.e. some
+// lipgloss.Foreground (or equivalent) call actually reached the rendered
+// output rather than being stripped or never applied.
+func HasStyledForeground() Validator {
+	return func(output string, _ *TestResult) Validation {
+		name := "ansi: has a styled foreground"
+		screen, err := RenderedScreen(output, defaultANSICols, defaultANSIRows, defaultANSITimeout)
+		if err != nil {
+			return Validation{Name: name, Message: fmt.Sprintf("rendering frame: %v", err)}
+		}
+		ok := false
+		for _, row := range screen.Rows {
+			for _, cell := range row {
+				if cell.FG.Set {
+					ok = true
+					break
+				}
+			}
+			if ok {
+				break
+			}
+		}
+		return Validation{Name: name, Passed: ok, Score: boolToScore(ok), Message: fmt.Sprintf("styled foreground cell found: %v", ok)}
+	}
+}
+
+// CellHasFG checks that the cell at (row, col) in the generated program's
+// final frame has foreground color.
+func CellHasFG(row, col int, color Color) Validator {
+	return func(output string, _ *TestResult) Validation {
+		name := fmt.Sprintf("ansi: fg at (%d,%d)", row, col)
+		screen, err := RenderedScreen(output, defaultANSICols, defaultANSIRows, defaultANSITimeout)
+		if err != nil {
+			return Validation{Name: name, Message: fmt.Sprintf("rendering frame: %v", err)}
+		}
+		cell := screen.At(row, col)
+		ok := cell.FG == color
+		return Validation{Name: name, Passed: ok, Score: boolToScore(ok), Message: fmt.Sprintf("expected fg %+v, got %+v", color, cell.FG)}
+	}
+}
+
+// NoOverlappingWidgets checks that the generated program's final frame
+// has no two bordered widgets occupying the same cell - see
+// Screen.DetectOverlap for what this can and can't catch.
+func NoOverlappingWidgets() Validator {
+	return func(output string, _ *TestResult) Validation {
+		name := "ansi: no overlapping widgets"
+		screen, err := RenderedScreen(output, defaultANSICols, defaultANSIRows, defaultANSITimeout)
+		if err != nil {
+			return Validation{Name: name, Message: fmt.Sprintf("rendering frame: %v", err)}
+		}
+		ok, msg := screen.DetectOverlap()
+		return Validation{Name: name, Passed: ok, Score: boolToScore(ok), Message: msg}
+	}
+}
@@ -0,0 +1,220 @@
+package skilltests
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// baselineWindow caps how many recent runs CaseBaseline.Runs keeps, so a
+// case's pass rate tracks its recent behavior rather than its entire
+// history.
+const baselineWindow = 10
+
+// defaultRegressionDelta is the pass-rate drop RunSuite treats as a
+// regression when TestRunner.RegressionDelta is left at 0.
+const defaultRegressionDelta = 0.20
+
+// BaselineRun is one case's outcome from a single past RunSuite call.
+type BaselineRun struct {
+	Passed bool    `json:"passed"`
+	Score  float64 `json:"score"`
+}
+
+// CaseBaseline is the rolling history BaselineStore keeps for one
+// "suite/case" key.
+type CaseBaseline struct {
+	Runs []BaselineRun `json:"runs"`
+}
+
+// passRate returns the fraction of b.Runs that passed, and whether there's
+// any history at all.
+func (b *CaseBaseline) passRate() (float64, bool) {
+	if b == nil || len(b.Runs) == 0 {
+		return 0, false
+	}
+	passed := 0
+	for _, r := range b.Runs {
+		if r.Passed {
+			passed++
+		}
+	}
+	return float64(passed) / float64(len(b.Runs)), true
+}
+
+// BaselineFlag is one thing Compare noticed about a case relative to its
+// BaselineStore history.
+type BaselineFlag struct {
+	Case    string  `json:"case"`
+	Kind    string  `json:"kind"` // "regression", "flaky", or "promotable"
+	Message string  `json:"message"`
+	Rate    float64 `json:"rate"` // This run's pass rate across its iterations
+}
+
+const (
+	BaselineRegression = "regression"
+	BaselineFlaky      = "flaky"
+	BaselinePromotable = "promotable"
+)
+
+// BaselineStore is a JSON file of rolling per-case pass rates and scores,
+// so a suite with inherently noisy LLM-graded cases can be judged against
+// its own recent history instead of a single hard threshold. RunSuite loads
+// one lazily from TestRunner.BaselinePath (results/baseline.json by
+// default), compares the run it just finished against it, and - only when
+// TestRunner.BaselineUpdate is set, the same opt-in shape as UpdateGolden -
+// records the run and saves it back.
+type BaselineStore struct {
+	Path  string                   `json:"-"`
+	Cases map[string]*CaseBaseline `json:"cases"`
+
+	mu sync.Mutex
+}
+
+// LoadBaselineStore reads path, tolerating a missing file by returning an
+// empty store - a suite with no baseline yet just gets no flags until
+// -baseline-update records its first run.
+func LoadBaselineStore(path string) (*BaselineStore, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &BaselineStore{Path: path, Cases: map[string]*CaseBaseline{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading baseline store %s: %w", path, err)
+	}
+
+	store := &BaselineStore{Path: path}
+	if err := json.Unmarshal(data, store); err != nil {
+		return nil, fmt.Errorf("parsing baseline store %s: %w", path, err)
+	}
+	if store.Cases == nil {
+		store.Cases = map[string]*CaseBaseline{}
+	}
+	return store, nil
+}
+
+// Save writes s back to s.Path as indented JSON, creating its parent
+// directory if needed.
+func (s *BaselineStore) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0755); err != nil {
+		return fmt.Errorf("creating baseline dir: %w", err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling baseline store: %w", err)
+	}
+	return os.WriteFile(s.Path, data, 0644)
+}
+
+// caseKey identifies a case within s, namespaced by suite so two suites can
+// each have a case named the same thing without colliding.
+func caseKey(suiteName, caseName string) string {
+	return suiteName + "/" + caseName
+}
+
+// Compare groups result.Results by case name and checks each against s's
+// recorded history (before this run is folded in), returning one
+// BaselineFlag per case that looks like a regression, is flaky this run, or
+// is a promotion candidate. delta overrides defaultRegressionDelta when > 0.
+func (s *BaselineStore) Compare(result *SuiteResult, delta float64) []BaselineFlag {
+	if s == nil {
+		return nil
+	}
+	if delta <= 0 {
+		delta = defaultRegressionDelta
+	}
+
+	byCase := map[string][]*TestResult{}
+	var order []string
+	for _, tr := range result.Results {
+		if _, seen := byCase[tr.Name]; !seen {
+			order = append(order, tr.Name)
+		}
+		byCase[tr.Name] = append(byCase[tr.Name], tr)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var flags []BaselineFlag
+	for _, name := range order {
+		runs := byCase[name]
+		passed := 0
+		for _, tr := range runs {
+			if tr.Passed {
+				passed++
+			}
+		}
+		rate := float64(passed) / float64(len(runs))
+
+		prior, hasHistory := s.Cases[caseKey(result.Name, name)].passRate()
+
+		if hasHistory && prior-rate > delta {
+			flags = append(flags, BaselineFlag{Case: name, Kind: BaselineRegression, Rate: rate,
+				Message: fmt.Sprintf("pass rate dropped from %.0f%% to %.0f%% (baseline)", prior*100, rate*100)})
+		}
+
+		if len(runs) > 1 && rate > 0.2 && rate < 0.8 {
+			flags = append(flags, BaselineFlag{Case: name, Kind: BaselineFlaky, Rate: rate,
+				Message: fmt.Sprintf("passed %d/%d iterations (%.0f%%) - inconsistent across a single run", passed, len(runs), rate*100)})
+		}
+
+		if hasHistory && prior < 1.0 && rate == 1.0 {
+			flags = append(flags, BaselineFlag{Case: name, Kind: BaselinePromotable, Rate: rate,
+				Message: "passed every iteration after a history of failures - consider raising Iterations to confirm"})
+		}
+	}
+
+	return flags
+}
+
+// Update folds result's per-case outcomes into s, capping each case's
+// history at baselineWindow runs. Call Save afterward to persist it.
+func (s *BaselineStore) Update(result *SuiteResult) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byCase := map[string][]*TestResult{}
+	for _, tr := range result.Results {
+		byCase[tr.Name] = append(byCase[tr.Name], tr)
+	}
+
+	if s.Cases == nil {
+		s.Cases = map[string]*CaseBaseline{}
+	}
+
+	for name, runs := range byCase {
+		passed := 0
+		totalScore := 0.0
+		for _, tr := range runs {
+			if tr.Passed {
+				passed++
+			}
+			totalScore += tr.Score
+		}
+
+		key := caseKey(result.Name, name)
+		b := s.Cases[key]
+		if b == nil {
+			b = &CaseBaseline{}
+			s.Cases[key] = b
+		}
+
+		b.Runs = append(b.Runs, BaselineRun{
+			Passed: passed == len(runs),
+			Score:  totalScore / float64(len(runs)),
+		})
+		if len(b.Runs) > baselineWindow {
+			b.Runs = b.Runs[len(b.Runs)-baselineWindow:]
+		}
+	}
+}
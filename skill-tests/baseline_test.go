@@ -0,0 +1,90 @@
+package skilltests
+
+import "testing"
+
+func TestCaseBaselinePassRate(t *testing.T) {
+	var nilBaseline *CaseBaseline
+	if rate, ok := nilBaseline.passRate(); ok || rate != 0 {
+		t.Errorf("nil CaseBaseline.passRate() = (%v, %v), want (0, false)", rate, ok)
+	}
+
+	empty := &CaseBaseline{}
+	if rate, ok := empty.passRate(); ok || rate != 0 {
+		t.Errorf("empty CaseBaseline.passRate() = (%v, %v), want (0, false)", rate, ok)
+	}
+
+	b := &CaseBaseline{Runs: []BaselineRun{{Passed: true}, {Passed: true}, {Passed: false}, {Passed: true}}}
+	rate, ok := b.passRate()
+	if !ok || rate != 0.75 {
+		t.Errorf("CaseBaseline.passRate() = (%v, %v), want (0.75, true)", rate, ok)
+	}
+}
+
+func TestBaselineStoreCompare(t *testing.T) {
+	store := &BaselineStore{Cases: map[string]*CaseBaseline{
+		"suite/regresses": {Runs: []BaselineRun{{Passed: true}, {Passed: true}, {Passed: true}, {Passed: true}}},
+		"suite/promotes":  {Runs: []BaselineRun{{Passed: false}, {Passed: false}, {Passed: true}}},
+		"suite/steady":    {Runs: []BaselineRun{{Passed: true}, {Passed: true}}},
+	}}
+
+	result := &SuiteResult{
+		Name: "suite",
+		Results: []*TestResult{
+			// Was passing 100% of the time, now fails every iteration -
+			// a clear regression.
+			{Name: "regresses", Passed: false},
+			{Name: "regresses", Passed: false},
+			// Passed only 1 of 3 recorded runs before, now passes every
+			// iteration - a promotion candidate.
+			{Name: "promotes", Passed: true},
+			{Name: "promotes", Passed: true},
+			// Inconsistent within this run alone, regardless of history -
+			// flagged as flaky.
+			{Name: "flaky-this-run", Passed: true},
+			{Name: "flaky-this-run", Passed: false},
+			{Name: "flaky-this-run", Passed: true},
+			// Always passed before, consistent this run - nothing to flag.
+			{Name: "steady", Passed: true},
+			{Name: "steady", Passed: true},
+		},
+	}
+
+	flags := store.Compare(result, 0)
+
+	byCase := map[string]BaselineFlag{}
+	for _, f := range flags {
+		byCase[f.Case] = f
+	}
+
+	if f, ok := byCase["regresses"]; !ok || f.Kind != BaselineRegression {
+		t.Errorf("expected a regression flag for 'regresses', got %+v (present: %v)", f, ok)
+	}
+	if f, ok := byCase["promotes"]; !ok || f.Kind != BaselinePromotable {
+		t.Errorf("expected a promotable flag for 'promotes', got %+v (present: %v)", f, ok)
+	}
+	if f, ok := byCase["flaky-this-run"]; !ok || f.Kind != BaselineFlaky {
+		t.Errorf("expected a flaky flag for 'flaky-this-run', got %+v (present: %v)", f, ok)
+	}
+	if _, ok := byCase["steady"]; ok {
+		t.Errorf("did not expect a flag for 'steady', a consistent pass with no regressing history")
+	}
+}
+
+func TestBaselineStoreUpdateCapsWindow(t *testing.T) {
+	store := &BaselineStore{Cases: map[string]*CaseBaseline{}}
+
+	for i := 0; i < baselineWindow+5; i++ {
+		store.Update(&SuiteResult{
+			Name:    "suite",
+			Results: []*TestResult{{Name: "case", Passed: true, Score: 1.0}},
+		})
+	}
+
+	b := store.Cases[caseKey("suite", "case")]
+	if b == nil {
+		t.Fatal("expected a baseline entry for suite/case")
+	}
+	if len(b.Runs) != baselineWindow {
+		t.Errorf("len(b.Runs) = %d, want %d (capped at baselineWindow)", len(b.Runs), baselineWindow)
+	}
+}
@@ -0,0 +1,107 @@
+// Command claude-skill-test runs the declarative suites/*.yaml suites
+// (skilltests.SuiteConfig) through the same TestRunner/RunSuite cmd/run-tests
+// uses for its compiled-in _test.go suites and its -suite flag, so a suite
+// author doesn't need a Go file at all - just a YAML document next to the
+// skill it tests. It emits the same *-results.json SaveSuiteResults always
+// has, so -o can point at the same output directory cmd/run-tests uses and
+// share one report.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+)
+
+func main() {
+	dir := flag.String("dir", "suites", "directory of *.yaml/*.yml suite files to run")
+	root := flag.String("root", ".", "project root (the directory containing .claude/)")
+	outputDir := flag.String("o", "/tmp/skill-tests", "output directory for *-results.json")
+	verbose := flag.Bool("v", false, "verbose output")
+	iterations := flag.Int("n", 3, "default iterations per case, when a case doesn't set its own")
+	updateGolden := flag.Bool("update-golden", false, "rewrite .txtar golden sections to match actual output instead of asserting against them")
+	flag.Parse()
+
+	paths, err := discoverSuites(*dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error discovering suites in %s: %v\n", *dir, err)
+		os.Exit(1)
+	}
+	if len(paths) == 0 {
+		fmt.Fprintf(os.Stderr, "No *.yaml/*.yml suites found in %s\n", *dir)
+		os.Exit(1)
+	}
+
+	runner := skilltests.NewTestRunner()
+	runner.WorkDir = *root
+	runner.Verbose = *verbose
+	runner.OutputDir = *outputDir
+	runner.UpdateGolden = *updateGolden
+
+	if err := os.MkdirAll(*outputDir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating output dir: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+	totalPassed, totalFailed := 0, 0
+
+	for _, path := range paths {
+		cfg, err := skilltests.LoadSuiteSpec(ctx, path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading %s: %v\n", path, err)
+			os.Exit(1)
+		}
+
+		for i := range cfg.Cases {
+			if cfg.Cases[i].Iterations == 0 {
+				cfg.Cases[i].Iterations = *iterations
+			}
+		}
+
+		suite, err := skilltests.BuildSuite(cfg, runner)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error building %s: %v\n", path, err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Running suite %q from %s (%d cases)\n", suite.Name, path, len(suite.Cases))
+		result, err := runner.RunSuite(ctx, suite)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error running %s: %v\n", path, err)
+			os.Exit(1)
+		}
+
+		if err := runner.SaveSuiteResults(result, suite.Name+"-results.json"); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving results for %s: %v\n", path, err)
+		}
+
+		fmt.Printf("  %d/%d passed\n", result.Passed, result.Passed+result.Failed)
+		totalPassed += result.Passed
+		totalFailed += result.Failed
+	}
+
+	fmt.Printf("\nTests: %d passed, %d failed\n", totalPassed, totalFailed)
+	if totalFailed > 0 {
+		os.Exit(1)
+	}
+}
+
+// discoverSuites returns every *.yaml/*.yml file directly under dir: all
+// *.yaml files, then all *.yml files, each group in filepath.Glob's own
+// lexical order so a run is reproducible.
+func discoverSuites(dir string) ([]string, error) {
+	var out []string
+	for _, ext := range []string{"*.yaml", "*.yml"} {
+		matches, err := filepath.Glob(filepath.Join(dir, ext))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, matches...)
+	}
+	return out, nil
+}
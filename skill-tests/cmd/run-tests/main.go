@@ -2,81 +2,376 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"time"
-)
 
-// Note: TestReport and SuiteReport types available for future JSON reporting
-// Currently using go test output directly
+	"github.com/charmbracelet/lipgloss"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+	"github.com/MateoSegura/.claude/skill-tests/dashboard"
+	"github.com/MateoSegura/.claude/theme"
+)
 
 func main() {
 	verbose := flag.Bool("v", false, "verbose output")
 	iterations := flag.Int("n", 3, "number of iterations per test")
 	outputDir := flag.String("o", "/tmp/skill-tests", "output directory")
+	reportDir := flag.String("report-dir", "", "where to write report.json/report.html (defaults to -o)")
+	format := flag.String("format", "text", "result report format: text, json, sarif, junit, or html")
+	tui := flag.Bool("tui", false, "launch an interactive dashboard instead of running tests non-interactively")
+	watch := flag.Bool("watch", false, "watch .claude extension directories and rerun tests on change (500ms debounce)")
+	printTheme := flag.Bool("print-theme", false, "print the effective merged theme (colors and icons) and exit")
+	suite := flag.String("suite", "", "load a Suite from a URL or local path (YAML/JSON) instead of the compiled-in _test.go suites")
+	updateGolden := flag.Bool("update-golden", false, "rewrite .txtar golden sections to match actual output instead of asserting against them")
+	baselineUpdate := flag.Bool("baseline-update", false, "snapshot this run into results/baseline.json instead of just comparing against it")
+	reporter := flag.String("reporter", "", "per-case/summary reporter: color (default), plain, or json; tui is only valid with -suite")
 	flag.Parse()
 
-	fmt.Println("╔══════════════════════════════════════════════════════════════╗")
-	fmt.Println("║               Claude Code Skill Test Runner                  ║")
-	fmt.Println("╚══════════════════════════════════════════════════════════════╝")
-	fmt.Println()
+	if *reporter == "tui" && *suite == "" {
+		fmt.Fprintln(os.Stderr, "Error: -reporter=tui is only supported together with -suite (the default run executes go test as a subprocess, which a live TUI can't share a terminal with); use -tui for the interactive dashboard instead")
+		os.Exit(1)
+	}
+
+	if *printTheme {
+		t, err := theme.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading theme: %v\n", err)
+			os.Exit(1)
+		}
+		printEffectiveTheme(t)
+		return
+	}
 
-	// Ensure output directory exists
-	if err := os.MkdirAll(*outputDir, 0755); err != nil {
-		fmt.Fprintf(os.Stderr, "Error creating output dir: %v\n", err)
+	switch *format {
+	case "text", "sarif", "json", "junit", "html":
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown -format %q (want text, json, sarif, junit, or html)\n", *format)
 		os.Exit(1)
 	}
 
-	start := time.Now()
+	if *reportDir == "" {
+		*reportDir = *outputDir
+	}
 
-	// Set environment for tests
-	os.Setenv("SKILL_TEST", "1")
+	if *suite != "" {
+		root, _ := os.Getwd()
+		passed, failed, err := runRemoteSuite(*suite, root, *reportDir, *verbose, *iterations, *updateGolden, *baselineUpdate, *reporter)
 
-	// Build test command
-	args := []string{"test", "-v", "./..."}
-	if *verbose {
-		args = append(args, "-v")
+		report, reportErr := buildReport(*reportDir)
+		if reportErr != nil {
+			fmt.Fprintf(os.Stderr, "Error building report: %v\n", reportErr)
+		} else {
+			saveReport(report, *reportDir, *format)
+		}
+		printSuiteSummary(report, passed, failed)
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error running suite %s: %v\n", *suite, err)
+			os.Exit(1)
+		}
+		if failed > 0 {
+			os.Exit(1)
+		}
+		return
 	}
-	args = append(args, fmt.Sprintf("-count=%d", *iterations))
 
-	// Find test directory
 	testDir := findTestDir()
 	if testDir == "" {
 		fmt.Println("Error: Could not find skill-tests directory")
 		os.Exit(1)
 	}
+	root := filepath.Dir(filepath.Dir(testDir)) // .claude/skill-tests -> .claude -> project root
 
-	fmt.Printf("Running tests from: %s\n", testDir)
-	fmt.Printf("Iterations per test: %d\n", *iterations)
-	fmt.Printf("Output directory: %s\n", *outputDir)
-	fmt.Println()
+	if *tui {
+		runner := skilltests.NewTestRunner()
+		runner.WorkDir = root
+		runner.Verbose = *verbose
+		if err := dashboard.Run(runner, root); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running dashboard: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
 
-	// Run tests
-	cmd := exec.Command("go", args...)
-	cmd.Dir = testDir
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	cmd.Env = append(os.Environ(), "SKILL_TEST=1")
+	if *watch {
+		if err := watchAndRerun(root, testDir, *reportDir, *format, *verbose, *iterations, *baselineUpdate); err != nil {
+			fmt.Fprintf(os.Stderr, "Error watching: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Println("╔══════════════════════════════════════════════════════════════╗")
+	fmt.Println("║               Claude Code Skill Test Runner                  ║")
+	fmt.Println("╚══════════════════════════════════════════════════════════════╝")
+	fmt.Println()
 
-	err := cmd.Run()
+	passed, failed, err := runTestsOnce(testDir, *reportDir, *format, *verbose, *iterations, *updateGolden, *baselineUpdate, *reporter)
 
-	duration := time.Since(start)
+	report, reportErr := buildReport(*reportDir)
+	if reportErr != nil {
+		fmt.Fprintf(os.Stderr, "Error building report: %v\n", reportErr)
+	} else {
+		saveReport(report, *reportDir, *format)
+	}
 
 	// Generate summary report
 	fmt.Println()
 	fmt.Println("════════════════════════════════════════════════════════════════")
 	fmt.Println("                         TEST SUMMARY                           ")
 	fmt.Println("════════════════════════════════════════════════════════════════")
-	fmt.Printf("Duration: %v\n", duration)
+	printSuiteSummary(report, passed, failed)
 
 	if err != nil {
-		fmt.Println("Status: SOME TESTS FAILED")
+		fmt.Println(statusStyle(failed > 0).Render("Status: SOME TESTS FAILED"))
 		os.Exit(1)
 	} else {
-		fmt.Println("Status: ALL TESTS PASSED")
+		fmt.Println(statusStyle(false).Render("Status: ALL TESTS PASSED"))
+	}
+}
+
+// saveReport writes report to reportDir in whichever of report.json/
+// report.html the requested format calls for; text/sarif/junit don't
+// produce an extra combined-report file.
+func saveReport(report *skilltests.TestReport, reportDir, format string) {
+	runner := &skilltests.TestRunner{OutputDir: reportDir}
+	switch format {
+	case "json":
+		if err := runner.SaveReport(report, "report.json"); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing report.json: %v\n", err)
+		}
+	case "html":
+		if err := runner.SaveHTML(report, "report.html"); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing report.html: %v\n", err)
+		}
+	}
+}
+
+// printSuiteSummary prints one colorized line per suite - green for an A/B
+// grade, yellow for C/D, red for F - so a struggling suite stands out in
+// the summary without needing -v to find it, then the aggregate totals.
+func printSuiteSummary(report *skilltests.TestReport, passed, failed int) {
+	if report != nil {
+		t := theme.Default()
+		for _, sr := range report.Suites {
+			var color lipgloss.Color
+			switch sr.Grade {
+			case "A", "B":
+				color = t.Success
+			case "C", "D":
+				color = t.Primary
+			default:
+				color = t.Error
+			}
+			style := lipgloss.NewStyle().Foreground(color)
+			fmt.Println(style.Render(fmt.Sprintf("  [%s] %s: %d/%d passed", sr.Grade, sr.Name, sr.Passed, sr.TotalTests)))
+		}
+	}
+	fmt.Printf("Tests: %d passed, %d failed\n", passed, failed)
+}
+
+// statusStyle colors the final pass/fail line: green when failed is false,
+// red otherwise.
+func statusStyle(failed bool) lipgloss.Style {
+	t := theme.Default()
+	if failed {
+		return lipgloss.NewStyle().Foreground(t.Error).Bold(true)
+	}
+	return lipgloss.NewStyle().Foreground(t.Success).Bold(true)
+}
+
+// runTestsOnce runs `go test` over testDir once, converts the results to
+// format when it's sarif or junit, and returns the total passed/failed
+// TestResult counts summed across every *-results.json written to
+// outputDir. updateGolden, baselineUpdate, and reporterName are forwarded
+// to the subprocess as UPDATE_GOLDEN/BASELINE_UPDATE/REPORTER, picked up by
+// NewTestRunner.
+func runTestsOnce(testDir, outputDir, format string, verbose bool, iterations int, updateGolden, baselineUpdate bool, reporterName string) (passed, failed int, err error) {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return 0, 0, fmt.Errorf("creating output dir: %w", err)
+	}
+
+	start := time.Now()
+
+	args := []string{"test", "-v", "./..."}
+	if verbose {
+		args = append(args, "-v")
+	}
+	args = append(args, fmt.Sprintf("-count=%d", iterations))
+
+	fmt.Printf("Running tests from: %s\n", testDir)
+	fmt.Printf("Iterations per test: %d\n", iterations)
+	fmt.Printf("Output directory: %s\n", outputDir)
+	fmt.Println()
+
+	cmd := exec.Command("go", args...)
+	cmd.Dir = testDir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), "SKILL_TEST=1")
+	if updateGolden {
+		cmd.Env = append(cmd.Env, "UPDATE_GOLDEN=1")
+	}
+	if baselineUpdate {
+		cmd.Env = append(cmd.Env, "BASELINE_UPDATE=1")
+	}
+	if reporterName != "" {
+		cmd.Env = append(cmd.Env, "REPORTER="+reporterName)
+	}
+	runErr := cmd.Run()
+
+	fmt.Printf("\nDuration: %v\n", time.Since(start))
+
+	// Each suite's *_test.go already wrote its SuiteResult to outputDir as
+	// plain JSON (TestRunner.SaveSuiteResults). For sarif/junit, re-emit
+	// those results in the requested format; text/json/html are built from
+	// this same JSON by the caller (see buildReport).
+	if format == "sarif" || format == "junit" {
+		if convErr := convertResults(outputDir, format); convErr != nil {
+			fmt.Fprintf(os.Stderr, "Error converting results to %s: %v\n", format, convErr)
+		}
+	}
+
+	passed, failed, sumErr := sumResults(outputDir)
+	if sumErr != nil {
+		return passed, failed, sumErr
+	}
+
+	return passed, failed, runErr
+}
+
+// loadResults reads every *-results.json SaveSuiteResults wrote to
+// outputDir back into SuiteResults.
+func loadResults(outputDir string) ([]*skilltests.SuiteResult, error) {
+	matches, err := filepath.Glob(filepath.Join(outputDir, "*-results.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*skilltests.SuiteResult, 0, len(matches))
+	for _, path := range matches {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+		var result skilltests.SuiteResult
+		if err := json.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", path, err)
+		}
+		results = append(results, &result)
+	}
+
+	return results, nil
+}
+
+// sumResults adds up Passed/Failed across every *-results.json in
+// outputDir.
+func sumResults(outputDir string) (passed, failed int, err error) {
+	results, err := loadResults(outputDir)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, result := range results {
+		passed += result.Passed
+		failed += result.Failed
+	}
+	return passed, failed, nil
+}
+
+// buildReport loads every *-results.json in outputDir and builds the
+// aggregated, graded TestReport that backs -format=json/html and the
+// colorized suite summary.
+func buildReport(outputDir string) (*skilltests.TestReport, error) {
+	results, err := loadResults(outputDir)
+	if err != nil {
+		return nil, err
+	}
+	return skilltests.BuildReport(results, skilltests.DefaultGradeScale()), nil
+}
+
+// convertResults re-emits every *-results.json SaveSuiteResults wrote to
+// outputDir as a SARIF or JUnit report alongside it.
+func convertResults(outputDir, format string) error {
+	matches, err := filepath.Glob(filepath.Join(outputDir, "*-results.json"))
+	if err != nil {
+		return err
+	}
+
+	runner := &skilltests.TestRunner{OutputDir: outputDir}
+
+	for _, path := range matches {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", path, err)
+		}
+
+		var result skilltests.SuiteResult
+		if err := json.Unmarshal(data, &result); err != nil {
+			return fmt.Errorf("parse %s: %w", path, err)
+		}
+
+		base := strings.TrimSuffix(filepath.Base(path), ".json")
+		switch format {
+		case "sarif":
+			err = runner.SaveSARIF(&result, base+".sarif")
+		case "junit":
+			err = runner.SaveJUnit(&result, base+".xml")
+		}
+		if err != nil {
+			return fmt.Errorf("write %s report for %s: %w", format, path, err)
+		}
+	}
+
+	return nil
+}
+
+// printEffectiveTheme dumps t's colors and icons to stdout, so a user who
+// dropped a theme.yaml at theme.DefaultPath can confirm what actually got
+// merged over Default() without reading the source.
+func printEffectiveTheme(t *theme.Theme) {
+	fmt.Println("Colors:")
+	colors := []struct {
+		name  string
+		value lipgloss.Color
+	}{
+		{"primary", t.Primary},
+		{"accent", t.Accent},
+		{"muted", t.Muted},
+		{"error", t.Error},
+		{"success", t.Success},
+		{"pending", t.Pending},
+		{"running", t.Running},
+		{"background", t.Background},
+		{"backgroundAlt", t.BackgroundAlt},
+		{"border", t.Border},
+		{"borderFocused", t.BorderFocused},
+	}
+	for _, c := range colors {
+		fmt.Printf("  %-14s %s\n", c.name, c.value)
+	}
+
+	fmt.Println("Icons:")
+	icons := []struct {
+		name  string
+		value string
+	}{
+		{"check", t.Icons.Check},
+		{"cross", t.Icons.Cross},
+		{"circle", t.Icons.Circle},
+		{"circleO", t.Icons.CircleO},
+		{"diamond", t.Icons.Diamond},
+		{"triangleR", t.Icons.TriangleR},
+		{"sparkle", t.Icons.Sparkle},
+		{"bullet", t.Icons.Bullet},
+	}
+	for _, i := range icons {
+		fmt.Printf("  %-14s %s\n", i.name, i.value)
 	}
 }
 
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+	"github.com/MateoSegura/.claude/skill-tests/dashboard"
+)
+
+// runRemoteSuite loads a Suite from loc (an http(s) URL or local path,
+// per skilltests.LoadSuiteSpec), runs it against root with a fresh
+// TestRunner, and saves the result to reportDir alongside the per-suite
+// JSON the compiled-in _test.go path writes, so buildReport/loadResults
+// treat a --suite run identically to a normal one. reporterName selects
+// runner.Reporter the same way -reporter does for a normal run, plus
+// "tui" - only available here and not for the default go-test-subprocess
+// path, since this is the one place RunSuite runs in this very process.
+func runRemoteSuite(loc, root, reportDir string, verbose bool, iterations int, updateGolden, baselineUpdate bool, reporterName string) (passed, failed int, err error) {
+	runner := skilltests.NewTestRunner()
+	runner.WorkDir = root
+	runner.Verbose = verbose
+	runner.OutputDir = reportDir
+	runner.UpdateGolden = updateGolden
+	runner.BaselineUpdate = baselineUpdate
+
+	var live *dashboard.LiveTUIReporter
+	if reporterName == "tui" {
+		live = dashboard.NewLiveTUIReporter()
+		runner.Reporter = live
+	} else if reporterName != "" {
+		reporter, err := skilltests.ReporterByName(reporterName)
+		if err != nil {
+			return 0, 0, err
+		}
+		runner.Reporter = reporter
+	}
+
+	ctx := context.Background()
+	cfg, err := skilltests.LoadSuiteSpec(ctx, loc)
+	if err != nil {
+		return 0, 0, fmt.Errorf("loading suite %s: %w", loc, err)
+	}
+
+	for i := range cfg.Cases {
+		if cfg.Cases[i].Iterations == 0 {
+			cfg.Cases[i].Iterations = iterations
+		}
+	}
+
+	suite, err := skilltests.BuildSuite(cfg, runner)
+	if err != nil {
+		return 0, 0, fmt.Errorf("building suite %s: %w", loc, err)
+	}
+
+	fmt.Printf("Running remote suite %q from %s (%d cases)\n", suite.Name, loc, len(suite.Cases))
+
+	if err := os.MkdirAll(reportDir, 0755); err != nil {
+		return 0, 0, fmt.Errorf("creating report dir: %w", err)
+	}
+
+	result, err := runner.RunSuite(ctx, suite)
+	if live != nil {
+		live.Wait()
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("running suite %s: %w", loc, err)
+	}
+
+	if saveErr := runner.SaveSuiteResults(result, suite.Name+"-results.json"); saveErr != nil {
+		return result.Passed, result.Failed, fmt.Errorf("saving suite results: %w", saveErr)
+	}
+
+	return result.Passed, result.Failed, nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// watchedKinds are the .claude subdirectories watchAndRerun monitors.
+var watchedKinds = []string{"skills", "commands", "rules", "agents", "hooks"}
+
+// watchAndRerun watches root's .claude extension directories and reruns
+// the full `go test` invocation, debounced by 500ms, whenever something
+// under them changes. It prints a colorized pass/fail delta after each
+// rerun.
+//
+// This reruns the whole suite rather than only the TestCases an edited
+// extension affects: cmd/run-tests shells out to `go test` and has no
+// access to the []*skilltests.Suite values those test binaries define
+// in-process. skilltests.Watcher provides that finer-grained,
+// Extension/ExtensionType-scoped rerun for callers that hold Suites
+// directly instead of going through this CLI.
+func watchAndRerun(root, testDir, outputDir, format string, verbose bool, iterations int, baselineUpdate bool) error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("watch: %w", err)
+	}
+	defer fsw.Close()
+
+	addDirs := func() {
+		for _, kind := range watchedKinds {
+			kindDir := filepath.Join(root, ".claude", kind)
+			filepath.Walk(kindDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil || !info.IsDir() {
+					return nil
+				}
+				fsw.Add(path) // re-adding an existing watch is a harmless no-op
+				return nil
+			})
+		}
+	}
+	addDirs()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Println("Watching .claude/{skills,commands,rules,agents,hooks} for changes (ctrl+c to stop)...")
+
+	prevPassed, prevFailed := -1, -1
+	rerun := func() {
+		passed, failed, err := runTestsOnce(testDir, outputDir, format, verbose, iterations, false, baselineUpdate, "")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "rerun error: %v\n", err)
+		}
+		printDelta(passed, failed, prevPassed, prevFailed)
+		prevPassed, prevFailed = passed, failed
+	}
+	rerun()
+
+	var timerC <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case ev, ok := <-fsw.Events:
+			if !ok {
+				return nil
+			}
+			if ev.Op&(fsnotify.Create|fsnotify.Rename) != 0 {
+				addDirs() // tree may have grown/moved an extension directory
+			}
+			timerC = time.After(500 * time.Millisecond)
+
+		case <-timerC:
+			timerC = nil
+			rerun()
+
+		case watchErr, ok := <-fsw.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "watch: %v\n", watchErr)
+		}
+	}
+}
+
+// printDelta prints passed/failed totals, and the change from the previous
+// run once there is one, colorized through theme.Default().
+func printDelta(passed, failed, prevPassed, prevFailed int) {
+	t := theme.Default()
+	goodStyle := lipgloss.NewStyle().Foreground(t.Success)
+	badStyle := lipgloss.NewStyle().Foreground(t.Error)
+
+	if prevPassed < 0 {
+		fmt.Printf("%s %d passed, %s %d failed\n", goodStyle.Render("●"), passed, badStyle.Render("●"), failed)
+		return
+	}
+
+	fmt.Printf("%s %d passed (%+d)   %s %d failed (%+d)\n",
+		goodStyle.Render("✓"), passed, passed-prevPassed,
+		badStyle.Render("✗"), failed, failed-prevFailed)
+}
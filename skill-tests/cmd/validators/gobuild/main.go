@@ -0,0 +1,67 @@
+// Command gobuild is a built-in skilltests validator plugin: it extracts
+// every ```go fenced code block from the request's output, writes each to
+// its own file in a scratch module, and runs `go vet` over it, catching
+// code that reads plausibly but doesn't actually compile. Register it with
+// runner.RegisterValidatorPlugin("gobuild", pathToThisBinary) or drop the
+// built binary under .claude/validators/ for auto-discovery.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+)
+
+var goFence = regexp.MustCompile("(?s)```go\\s*\\n(.*?)```")
+
+func main() {
+	var req skilltests.PluginRequest
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintf(os.Stderr, "decoding plugin request: %v\n", err)
+		os.Exit(1)
+	}
+	json.NewEncoder(os.Stdout).Encode(validate(req))
+}
+
+func validate(req skilltests.PluginRequest) skilltests.PluginResponse {
+	blocks := goFence.FindAllStringSubmatch(req.Output, -1)
+	if len(blocks) == 0 {
+		return skilltests.PluginResponse{Name: "gobuild", Passed: false, Message: "no ```go code blocks found in output"}
+	}
+
+	dir, err := os.MkdirTemp("", "gobuild-validator-")
+	if err != nil {
+		return skilltests.PluginResponse{Name: "gobuild", Passed: false, Message: fmt.Sprintf("creating scratch dir: %v", err)}
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module gobuild-validator\n\ngo 1.21\n"), 0644); err != nil {
+		return skilltests.PluginResponse{Name: "gobuild", Passed: false, Message: fmt.Sprintf("writing go.mod: %v", err)}
+	}
+
+	for i, block := range blocks {
+		path := filepath.Join(dir, fmt.Sprintf("block%d.go", i))
+		if err := os.WriteFile(path, []byte(block[1]), 0644); err != nil {
+			return skilltests.PluginResponse{Name: "gobuild", Passed: false, Message: fmt.Sprintf("writing %s: %v", path, err)}
+		}
+	}
+
+	cmd := exec.Command("go", "vet", "./...")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return skilltests.PluginResponse{
+			Name:        "gobuild",
+			Passed:      false,
+			Message:     fmt.Sprintf("go vet failed: %s", out),
+			Suggestions: []string{"fix the reported go vet errors in the extracted code block(s)"},
+		}
+	}
+
+	return skilltests.PluginResponse{Name: "gobuild", Passed: true, Score: 1, Message: fmt.Sprintf("%d code block(s) vet clean", len(blocks))}
+}
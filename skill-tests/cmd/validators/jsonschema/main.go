@@ -0,0 +1,114 @@
+// Command jsonschema is a built-in skilltests validator plugin: it checks
+// that the request's output contains JSON (a fenced ```json block, or the
+// whole output) conforming to a minimal schema passed via
+// PluginRequest.Config["schema"]. Register it with
+// runner.RegisterValidatorPlugin("jsonschema", pathToThisBinary) or drop
+// the built binary under .claude/validators/ for auto-discovery.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+)
+
+var jsonFence = regexp.MustCompile("(?s)```json\\s*(.*?)\\s*```")
+
+func main() {
+	var req skilltests.PluginRequest
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintf(os.Stderr, "decoding plugin request: %v\n", err)
+		os.Exit(1)
+	}
+	json.NewEncoder(os.Stdout).Encode(validate(req))
+}
+
+func validate(req skilltests.PluginRequest) skilltests.PluginResponse {
+	schema, _ := req.Config["schema"].(map[string]interface{})
+	if schema == nil {
+		return skilltests.PluginResponse{Name: "jsonschema", Passed: false, Message: `config["schema"] is required`}
+	}
+
+	raw := strings.TrimSpace(req.Output)
+	if m := jsonFence.FindStringSubmatch(req.Output); m != nil {
+		raw = m[1]
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return skilltests.PluginResponse{Name: "jsonschema", Passed: false, Message: fmt.Sprintf("output is not valid JSON: %v", err)}
+	}
+
+	if errs := checkSchema(value, schema, "$"); len(errs) > 0 {
+		return skilltests.PluginResponse{Name: "jsonschema", Passed: false, Message: strings.Join(errs, "; "), Suggestions: errs}
+	}
+	return skilltests.PluginResponse{Name: "jsonschema", Passed: true, Score: 1, Message: "output matches schema"}
+}
+
+// checkSchema recursively validates value against a JSON-schema subset
+// (type, required, properties, items) sufficient for the shapes skill
+// outputs actually produce; it doesn't attempt full draft-7 coverage
+// (oneOf/allOf/pattern/etc).
+func checkSchema(value interface{}, schema map[string]interface{}, path string) []string {
+	var errs []string
+
+	if wantType, ok := schema["type"].(string); ok {
+		if got := jsonType(value); got != wantType {
+			errs = append(errs, fmt.Sprintf("%s: want type %q, got %q", path, wantType, got))
+			return errs
+		}
+	}
+
+	if obj, ok := value.(map[string]interface{}); ok {
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, r := range required {
+				key, _ := r.(string)
+				if _, present := obj[key]; !present {
+					errs = append(errs, fmt.Sprintf("%s: missing required property %q", path, key))
+				}
+			}
+		}
+		if props, ok := schema["properties"].(map[string]interface{}); ok {
+			for key, propSchema := range props {
+				if v, present := obj[key]; present {
+					if ps, ok := propSchema.(map[string]interface{}); ok {
+						errs = append(errs, checkSchema(v, ps, path+"."+key)...)
+					}
+				}
+			}
+		}
+	}
+
+	if arr, ok := value.([]interface{}); ok {
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for i, elem := range arr {
+				errs = append(errs, checkSchema(elem, items, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	}
+
+	return errs
+}
+
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return "unknown"
+	}
+}
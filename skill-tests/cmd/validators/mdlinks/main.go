@@ -0,0 +1,71 @@
+// Command mdlinks is a built-in skilltests validator plugin: it extracts
+// every Markdown link from the request's output and checks that relative
+// links resolve to a real file under PluginRequest.Config["base_dir"] (the
+// test workspace, typically), catching a skill that tells the user to "see
+// docs/setup.md" when it wrote no such file. http(s) links are only
+// checked for a non-empty host, not fetched. Register it with
+// runner.RegisterValidatorPlugin("mdlinks", pathToThisBinary) or drop the
+// built binary under .claude/validators/ for auto-discovery.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+)
+
+var mdLink = regexp.MustCompile(`\[[^\]]*\]\(([^)\s]+)\)`)
+
+func main() {
+	var req skilltests.PluginRequest
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintf(os.Stderr, "decoding plugin request: %v\n", err)
+		os.Exit(1)
+	}
+	json.NewEncoder(os.Stdout).Encode(validate(req))
+}
+
+func validate(req skilltests.PluginRequest) skilltests.PluginResponse {
+	baseDir, _ := req.Config["base_dir"].(string)
+
+	var broken []string
+	for _, m := range mdLink.FindAllStringSubmatch(req.Output, -1) {
+		target := m[1]
+
+		if u, err := url.Parse(target); err == nil && u.IsAbs() {
+			if u.Host == "" {
+				broken = append(broken, fmt.Sprintf("%s: absolute link with no host", target))
+			}
+			continue
+		}
+
+		if strings.HasPrefix(target, "#") {
+			continue // in-page anchor: no file to check
+		}
+
+		if baseDir == "" {
+			continue // no workspace to resolve relative links against
+		}
+
+		path := filepath.Join(baseDir, strings.SplitN(target, "#", 2)[0])
+		if _, err := os.Stat(path); err != nil {
+			broken = append(broken, fmt.Sprintf("%s: %v", target, err))
+		}
+	}
+
+	if len(broken) > 0 {
+		return skilltests.PluginResponse{
+			Name:        "mdlinks",
+			Passed:      false,
+			Message:     fmt.Sprintf("%d broken link(s): %s", len(broken), strings.Join(broken, "; ")),
+			Suggestions: broken,
+		}
+	}
+	return skilltests.PluginResponse{Name: "mdlinks", Passed: true, Score: 1, Message: "all links resolve"}
+}
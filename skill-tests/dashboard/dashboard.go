@@ -0,0 +1,349 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// Icons come from the active theme.Theme (m.theme.Icons), so a
+// ~/.claude/theme.yaml restyles the dashboard the same way it restyles the
+// k9s-tui-style scaffolds.
+
+type pane int
+
+const (
+	paneList pane = iota
+	paneDetail
+)
+
+type keyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Select key.Binding
+	Back   key.Binding
+	Run    key.Binding
+	Clear  key.Binding
+	Quit   key.Binding
+}
+
+var keys = keyMap{
+	Up:     key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up")),
+	Down:   key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", "down")),
+	Select: key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "detail")),
+	Back:   key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "back")),
+	Run:    key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "run")),
+	Clear:  key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "clear")),
+	Quit:   key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
+}
+
+// Model is the dashboard's top-level Bubble Tea model: a k9s-style list of
+// discovered extensions, and a detail viewport for the highlighted
+// extension's most recent test results that enter opens in place of the
+// list and esc closes again.
+type Model struct {
+	runner *skilltests.TestRunner
+	root   string
+
+	items  []Item
+	cursor int
+	pane   pane
+	detail viewport.Model
+
+	width, height int
+	theme         *theme.Theme
+	status        string
+}
+
+// New creates a dashboard over items discovered under root, driving test
+// runs through runner.
+func New(runner *skilltests.TestRunner, root string, items []Item) Model {
+	t, err := theme.Load()
+	if err != nil {
+		t = theme.Default()
+	}
+	return Model{
+		runner: runner,
+		root:   root,
+		items:  items,
+		detail: viewport.New(0, 0),
+		theme:  t,
+	}
+}
+
+// Run discovers extensions under root and blocks running the dashboard
+// until the user quits.
+func Run(runner *skilltests.TestRunner, root string) error {
+	items, err := Discover(root)
+	if err != nil {
+		return err
+	}
+	_, err = tea.NewProgram(New(runner, root, items), tea.WithAltScreen()).Run()
+	return err
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.detail.Width = msg.Width
+		m.detail.Height = msg.Height - 4
+		return m, nil
+
+	case runStartedMsg:
+		m.setState(msg.index, "running", nil)
+		m.status = fmt.Sprintf("running %s…", m.items[msg.index].Name)
+		return m, nil
+
+	case runFinishedMsg:
+		state := "failed"
+		if msg.result != nil && msg.result.Passed {
+			state = "success"
+		}
+		m.setState(msg.index, state, msg.result)
+		if msg.err != nil {
+			m.status = fmt.Sprintf("%s: %v", m.items[msg.index].Name, msg.err)
+		} else {
+			m.status = fmt.Sprintf("%s: %s", m.items[msg.index].Name, state)
+		}
+		if m.pane == paneDetail && m.cursor == msg.index {
+			m.detail.SetContent(renderDetail(m.items[msg.index]))
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	if m.pane == paneDetail {
+		var cmd tea.Cmd
+		m.detail, cmd = m.detail.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, keys.Quit):
+		return m, tea.Quit
+
+	case key.Matches(msg, keys.Back):
+		if m.pane == paneDetail {
+			m.pane = paneList
+		}
+		return m, nil
+	}
+
+	if m.pane == paneDetail {
+		var cmd tea.Cmd
+		m.detail, cmd = m.detail.Update(msg)
+		return m, cmd
+	}
+
+	switch {
+	case key.Matches(msg, keys.Up):
+		if m.cursor > 0 {
+			m.cursor--
+		}
+
+	case key.Matches(msg, keys.Down):
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+
+	case key.Matches(msg, keys.Select):
+		if len(m.items) > 0 {
+			m.pane = paneDetail
+			m.detail.SetContent(renderDetail(m.items[m.cursor]))
+		}
+
+	case key.Matches(msg, keys.Run):
+		if len(m.items) > 0 {
+			return m, m.runCmd(m.cursor)
+		}
+
+	case key.Matches(msg, keys.Clear):
+		if len(m.items) > 0 {
+			m.setState(m.cursor, "pending", nil)
+			m.status = fmt.Sprintf("cleared %s", m.items[m.cursor].Name)
+		}
+	}
+
+	return m, nil
+}
+
+func (m *Model) setState(index int, state string, result *TestSummary) {
+	if index < 0 || index >= len(m.items) {
+		return
+	}
+	m.items[index].State = state
+	m.items[index].UpdatedAt = time.Now()
+	if state != "running" {
+		m.items[index].LastResult = result
+	}
+}
+
+// runCmd fires off a single ad-hoc TestCase against the item at index and
+// reports back as the run starts and finishes, mirroring the
+// started/done pair TestRunner.RunSuite emits on its own Progress channel.
+func (m Model) runCmd(index int) tea.Cmd {
+	item := m.items[index]
+	started := func() tea.Msg { return runStartedMsg{index: index} }
+	run := func() tea.Msg {
+		tc := &skilltests.TestCase{
+			Name:   item.Name,
+			Skill:  item.Name,
+			Prompt: fmt.Sprintf("Review the %s at %s. Does it work as intended?", strings.TrimSuffix(item.Kind, "s"), item.Path),
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), m.runner.Timeout)
+		defer cancel()
+
+		result, err := m.runner.Run(ctx, tc)
+		if err != nil {
+			return runFinishedMsg{index: index, err: err}
+		}
+		return runFinishedMsg{index: index, result: toSummary(result)}
+	}
+
+	return tea.Batch(started, run)
+}
+
+func toSummary(tr *skilltests.TestResult) *TestSummary {
+	s := &TestSummary{Passed: tr.Passed, Score: tr.Score}
+	for _, v := range tr.Validations {
+		s.Validations = append(s.Validations, ValidationSummary{Name: v.Name, Passed: v.Passed, Message: v.Message})
+	}
+	return s
+}
+
+type runStartedMsg struct {
+	index int
+}
+
+type runFinishedMsg struct {
+	index  int
+	result *TestSummary
+	err    error
+}
+
+func (m Model) View() string {
+	if m.width == 0 {
+		return ""
+	}
+
+	var body string
+	if m.pane == paneDetail {
+		body = m.detail.View()
+	} else {
+		body = m.renderList()
+	}
+
+	header := m.theme.HeaderStyle().Width(m.width).Render(
+		lipgloss.NewStyle().Foreground(m.theme.Primary).Bold(true).Render("Claude Code Extensions"),
+	)
+	footer := m.renderFooter()
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, footer)
+}
+
+func (m Model) renderList() string {
+	var lines []string
+	for i, item := range m.items {
+		lines = append(lines, m.renderItem(item, i == m.cursor))
+	}
+	if len(lines) == 0 {
+		return lipgloss.NewStyle().Foreground(m.theme.Muted).Render("No extensions found under .claude/")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+func (m Model) renderItem(item Item, selected bool) string {
+	icon, color := stateIconColor(item.State, m.theme)
+	stateIcon := lipgloss.NewStyle().Foreground(color).Render(icon)
+
+	selector := "  "
+	nameStyle := lipgloss.NewStyle()
+	if selected {
+		selector = lipgloss.NewStyle().Foreground(m.theme.Primary).Render(m.theme.Icons.TriangleR + " ")
+		nameStyle = nameStyle.Foreground(m.theme.Primary).Bold(true)
+	}
+
+	kind := lipgloss.NewStyle().Foreground(m.theme.Muted).Width(10).Render(item.Kind)
+	name := nameStyle.Width(30).Render(item.Name)
+
+	return selector + stateIcon + " " + kind + name
+}
+
+func stateIconColor(state string, t *theme.Theme) (string, lipgloss.Color) {
+	switch state {
+	case "running":
+		return t.Icons.Circle, t.Running
+	case "success":
+		return t.Icons.Check, t.Success
+	case "failed":
+		return t.Icons.Cross, t.Error
+	default:
+		return t.Icons.CircleO, t.Pending
+	}
+}
+
+func (m Model) renderFooter() string {
+	shortcuts := []string{
+		m.shortcut("↑↓", "Navigate"),
+		m.shortcut("enter", "Detail"),
+		m.shortcut("n", "Run"),
+		m.shortcut("d", "Clear"),
+		m.shortcut("esc", "Back"),
+		m.shortcut("q", "Quit"),
+	}
+
+	line := strings.Join(shortcuts, "  ")
+	if m.status != "" {
+		line = m.status + "    " + line
+	}
+	return m.theme.FooterStyle().Width(m.width).Render(line)
+}
+
+func (m Model) shortcut(k, desc string) string {
+	return m.theme.ShortcutKeyStyle().Render("<"+k+">") +
+		lipgloss.NewStyle().Foreground(m.theme.Muted).Render(desc)
+}
+
+func renderDetail(item Item) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s  %s\n", item.Kind, item.Name)
+	fmt.Fprintf(&b, "path: %s\n", item.Path)
+	fmt.Fprintf(&b, "state: %s\n\n", item.State)
+
+	if item.LastResult == nil {
+		b.WriteString("No run yet. Press n from the list to run this extension.\n")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "passed: %v   score: %.2f%%\n\n", item.LastResult.Passed, item.LastResult.Score*100)
+	b.WriteString("validations:\n")
+	for _, v := range item.LastResult.Validations {
+		mark := "✗"
+		if v.Passed {
+			mark = "✓"
+		}
+		fmt.Fprintf(&b, "  %s %s: %s\n", mark, v.Name, v.Message)
+	}
+	return b.String()
+}
@@ -0,0 +1,93 @@
+// Package dashboard implements an interactive, k9s-style terminal dashboard
+// for browsing a project's Claude Code extensions (skills, commands, rules,
+// agents, hooks) and driving skilltests.TestRunner against them, so a
+// developer can explore and re-run tests without leaving the terminal.
+package dashboard
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// extensionKinds lists the .claude subdirectories Discover walks, in the
+// order they're grouped in the list view.
+var extensionKinds = []string{"skills", "commands", "rules", "agents", "hooks"}
+
+// Item is one discovered extension: a skill, command, rule, agent, or hook.
+// Its State/UpdatedAt/LastResult track the most recent run triggered from
+// the dashboard, not anything persisted to disk.
+type Item struct {
+	Kind       string // "skills", "commands", "rules", "agents", or "hooks"
+	Name       string
+	Path       string // path relative to the project root
+	State      string // "running", "success", "failed", or "pending"
+	UpdatedAt  time.Time
+	LastResult *TestSummary
+}
+
+// TestSummary is the subset of a skilltests.TestResult the detail view
+// renders, kept independent of the skilltests package so dashboard doesn't
+// need a TestRunner to display a cached result.
+type TestSummary struct {
+	Passed      bool
+	Score       float64
+	Validations []ValidationSummary
+}
+
+// ValidationSummary is one skilltests.Validation's name/pass/message.
+type ValidationSummary struct {
+	Name    string
+	Passed  bool
+	Message string
+}
+
+// Discover walks root's .claude/{skills,commands,rules,agents,hooks}
+// directories and returns one Item per top-level entry found, in pending
+// state. A missing kind directory is skipped rather than treated as an
+// error, since most projects won't define every kind.
+func Discover(root string) ([]Item, error) {
+	var items []Item
+
+	for _, kind := range extensionKinds {
+		kindDir := filepath.Join(root, ".claude", kind)
+		entries, err := os.ReadDir(kindDir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var names []string
+		for _, e := range entries {
+			name := e.Name()
+			if strings.HasPrefix(name, ".") {
+				continue
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			items = append(items, Item{
+				Kind:  kind,
+				Name:  trimExt(name),
+				Path:  filepath.Join(".claude", kind, name),
+				State: "pending",
+			})
+		}
+	}
+
+	return items, nil
+}
+
+func trimExt(name string) string {
+	ext := filepath.Ext(name)
+	if ext == "" {
+		return name
+	}
+	return name[:len(name)-len(ext)]
+}
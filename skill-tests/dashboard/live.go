@@ -0,0 +1,149 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// LiveTUIReporter is a skilltests.Reporter that streams a running suite
+// into a k9s-style split view: completed cases in a list on the left (one
+// line per case, as Model lists discovered extensions), the most recently
+// completed case's full output in a viewport on the right. It's the
+// `-reporter=tui` counterpart to ColorReporter/PlainReporter for a suite
+// that takes the 20-30 minutes this chunk's context timeouts imply, where
+// scrolling plain-text output isn't useful to watch live.
+type LiveTUIReporter struct {
+	program *tea.Program
+	done    chan struct{}
+}
+
+// NewLiveTUIReporter starts the live program on the alt screen and returns
+// immediately; CaseResult/Summary feed it asynchronously via
+// tea.Program.Send. Call Wait after the suite (and its final Summary) to
+// block until the user quits and see the finished view.
+func NewLiveTUIReporter() *LiveTUIReporter {
+	program := tea.NewProgram(newLiveModel(), tea.WithAltScreen())
+	r := &LiveTUIReporter{program: program, done: make(chan struct{})}
+	go func() {
+		program.Run()
+		close(r.done)
+	}()
+	return r
+}
+
+// CaseResult implements skilltests.Reporter.
+func (r *LiveTUIReporter) CaseResult(result *skilltests.TestResult) {
+	r.program.Send(liveCaseMsg{result: result})
+}
+
+// Summary implements skilltests.Reporter.
+func (r *LiveTUIReporter) Summary(result *skilltests.SuiteResult) {
+	r.program.Send(liveSummaryMsg{result: result})
+}
+
+// Wait blocks until the user quits the live view (q/ctrl+c), which they can
+// only do once Summary has been delivered.
+func (r *LiveTUIReporter) Wait() {
+	<-r.done
+}
+
+type liveCaseMsg struct {
+	result *skilltests.TestResult
+}
+
+type liveSummaryMsg struct {
+	result *skilltests.SuiteResult
+}
+
+// liveModel is LiveTUIReporter's Bubble Tea model: one line per completed
+// case on the left, the latest case's output on the right.
+type liveModel struct {
+	cases   []*skilltests.TestResult
+	detail  viewport.Model
+	summary *skilltests.SuiteResult
+
+	width, height int
+	theme         *theme.Theme
+}
+
+func newLiveModel() liveModel {
+	return liveModel{detail: viewport.New(0, 0), theme: theme.Default()}
+}
+
+func (m liveModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m liveModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.detail.Width = msg.Width / 2
+		m.detail.Height = msg.Height - 4
+		return m, nil
+
+	case liveCaseMsg:
+		m.cases = append(m.cases, msg.result)
+		m.detail.SetContent(msg.result.Output)
+		m.detail.GotoBottom()
+		return m, nil
+
+	case liveSummaryMsg:
+		m.summary = msg.result
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c":
+			if m.summary != nil {
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.detail, cmd = m.detail.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m liveModel) View() string {
+	if m.width == 0 {
+		return ""
+	}
+
+	listStyle := lipgloss.NewStyle().Width(m.width/2 - 1).Height(m.height - 4)
+	var lines []string
+	for _, r := range m.cases {
+		mark := m.theme.Icons.Check
+		color := m.theme.Success
+		if !r.Passed {
+			mark, color = m.theme.Icons.Cross, m.theme.Error
+		}
+		style := lipgloss.NewStyle().Foreground(color)
+		lines = append(lines, style.Render(fmt.Sprintf("%s %s (iter %d) %.0f%%", mark, r.Name, r.Iteration, r.Score*100)))
+	}
+	list := listStyle.Render(strings.Join(lines, "\n"))
+
+	body := lipgloss.JoinHorizontal(lipgloss.Top, list, m.detail.View())
+
+	header := m.theme.HeaderStyle().Width(m.width).Render(
+		lipgloss.NewStyle().Foreground(m.theme.Primary).Bold(true).Render("Skill Test Suite (live)"),
+	)
+
+	footer := "running… (q to quit once finished)"
+	if m.summary != nil {
+		footer = fmt.Sprintf("%d/%d passed, score %.2f%% (q to quit)",
+			m.summary.Passed, m.summary.TotalTests, m.summary.Score*100)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, body, m.theme.FooterStyle().Width(m.width).Render(footer))
+}
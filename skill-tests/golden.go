@@ -0,0 +1,246 @@
+package skilltests
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/txtar"
+	"gopkg.in/yaml.v3"
+)
+
+// ansiEscape matches terminal color/cursor escape sequences, stripped from
+// both sides of a golden comparison since a run's ANSI styling (picked up
+// from theme.Default(), see watch.go's printDelta) is cosmetic and would
+// otherwise make every golden fixture terminal-dependent.
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*[a-zA-Z]`)
+
+// GoldenFixture is a .txtar file holding one or more TestCases' prompts,
+// optional setup files, and named golden sections, modeled on the gopls
+// test framework's txtar-based test data. LoadSuiteFromTxtar parses one of
+// these into a Suite; GoldenValidator reads (or, with TestRunner.UpdateGolden,
+// rewrites) a case's named section.
+type GoldenFixture struct {
+	path    string
+	mu      sync.Mutex
+	archive *txtar.Archive
+}
+
+// LoadGoldenFixture parses the .txtar file at path.
+func LoadGoldenFixture(path string) (*GoldenFixture, error) {
+	archive, err := txtar.ParseFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("parsing golden fixture %s: %w", path, err)
+	}
+	return &GoldenFixture{path: path, archive: archive}, nil
+}
+
+// section returns the named golden section for caseName, stored as the
+// archive file "<caseName>/golden/<name>".
+func (g *GoldenFixture) section(caseName, name string) (string, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	target := caseName + "/golden/" + name
+	for _, f := range g.archive.Files {
+		if f.Name == target {
+			return string(f.Data), true
+		}
+	}
+	return "", false
+}
+
+// setSection rewrites (or adds) the named golden section for caseName and
+// saves the archive back to disk, for -update-golden runs.
+func (g *GoldenFixture) setSection(caseName, name, content string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	target := caseName + "/golden/" + name
+	data := []byte(content)
+	for i, f := range g.archive.Files {
+		if f.Name == target {
+			g.archive.Files[i].Data = data
+			return os.WriteFile(g.path, txtar.Format(g.archive), 0644)
+		}
+	}
+	g.archive.Files = append(g.archive.Files, txtar.File{Name: target, Data: data})
+	return os.WriteFile(g.path, txtar.Format(g.archive), 0644)
+}
+
+// file returns the raw content of an arbitrary archive file, used to pull a
+// case's prompt/context/setup files out of the fixture.
+func (g *GoldenFixture) file(name string) (string, bool) {
+	for _, f := range g.archive.Files {
+		if f.Name == name {
+			return string(f.Data), true
+		}
+	}
+	return "", false
+}
+
+// filesUnder returns every archive file under the "<prefix>/" directory,
+// keyed by the path relative to prefix, for copying a case's setup/ files
+// into a fresh workDir.
+func (g *GoldenFixture) filesUnder(prefix string) map[string][]byte {
+	out := make(map[string][]byte)
+	for _, f := range g.archive.Files {
+		if rel, ok := strings.CutPrefix(f.Name, prefix+"/"); ok {
+			out[rel] = f.Data
+		}
+	}
+	return out
+}
+
+// normalizeGolden trims surrounding whitespace, strips ANSI escapes, and
+// replaces every scrubber match with "<SCRUBBED>" so volatile substrings
+// (timestamps, temp paths, run IDs) don't break an otherwise-stable golden
+// comparison.
+func normalizeGolden(s string, scrubbers []*regexp.Regexp) string {
+	s = ansiEscape.ReplaceAllString(s, "")
+	s = strings.TrimSpace(s)
+	for _, scrub := range scrubbers {
+		s = scrub.ReplaceAllString(s, "<SCRUBBED>")
+	}
+	return s
+}
+
+// SortedFileList copies and sorts names, for comparing a run's created-file
+// listing against a golden section without caring what order the runner
+// happened to report them in.
+func SortedFileList(names []string) []string {
+	out := append([]string(nil), names...)
+	sort.Strings(out)
+	return out
+}
+
+// GoldenValidator compares output against fixture's "<caseName>/golden/<name>"
+// section, after normalizeGolden runs on both sides. r is consulted live
+// (not captured at build time) so a single fixture built once by
+// LoadSuiteFromTxtar still honors -update-golden on whichever runner
+// eventually executes the case: when r.UpdateGolden is set, the section is
+// overwritten with output instead of compared against it, and the
+// validation always passes.
+func GoldenValidator(r *TestRunner, fixture *GoldenFixture, caseName, name string, scrubbers ...*regexp.Regexp) Validator {
+	return func(output string, _ *TestResult) Validation {
+		vname := fmt.Sprintf("golden: %s/%s", caseName, name)
+		got := normalizeGolden(output, scrubbers)
+
+		if r.UpdateGolden {
+			if err := fixture.setSection(caseName, name, got); err != nil {
+				return Validation{Name: vname, Passed: false, Message: fmt.Sprintf("updating golden section: %v", err)}
+			}
+			return Validation{Name: vname, Passed: true, Score: 1, Message: "golden section updated"}
+		}
+
+		want, ok := fixture.section(caseName, name)
+		if !ok {
+			return Validation{Name: vname, Passed: false, Message: fmt.Sprintf("no golden section %q for case %q (run with -update-golden to create it)", name, caseName)}
+		}
+		want = normalizeGolden(want, scrubbers)
+
+		passed := got == want
+		msg := "matches golden"
+		if !passed {
+			msg = fmt.Sprintf("output does not match golden section %q:\n--- want ---\n%s\n--- got ---\n%s", name, want, got)
+		}
+		return Validation{Name: vname, Passed: passed, Score: boolToScore(passed), Message: msg}
+	}
+}
+
+// LoadSuiteFromTxtar loads an entire Suite from a .txtar fixture: the
+// archive comment is a SuiteConfig (name/skill/cases, minus each case's
+// Prompt/Context, which live in the archive proper so they sit next to the
+// golden output they produce), and each ValidatorConfig of type "golden"
+// resolves against the same fixture. This is meant to replace a
+// hand-written _test.go suite with one data file per skill, the way
+// bubbletea_test.go/extensions_test.go/k9s_test.go each define theirs today.
+func LoadSuiteFromTxtar(r *TestRunner, path string) (*Suite, error) {
+	fixture, err := LoadGoldenFixture(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg SuiteConfig
+	if err := yaml.Unmarshal(fixture.archive.Comment, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing %s suite header: %w", path, err)
+	}
+
+	suite := &Suite{Name: cfg.Name, Skill: cfg.Skill}
+
+	for _, tcCfg := range cfg.Cases {
+		skill := tcCfg.Skill
+		if skill == "" {
+			skill = cfg.Skill
+		}
+
+		prompt, ok := fixture.file(tcCfg.Name + "/prompt")
+		if !ok {
+			return nil, fmt.Errorf("%s: case %s has no %q file", path, tcCfg.Name, tcCfg.Name+"/prompt")
+		}
+
+		tc := &TestCase{
+			Name:          tcCfg.Name,
+			Skill:         skill,
+			Prompt:        strings.TrimSuffix(prompt, "\n"),
+			Iterations:    tcCfg.Iterations,
+			MaxRetries:    tcCfg.MaxRetries,
+			Serial:        tcCfg.Serial,
+			Extension:     tcCfg.Extension,
+			ExtensionType: tcCfg.ExtensionType,
+		}
+
+		if context, ok := fixture.file(tcCfg.Name + "/context"); ok {
+			tc.Context = strings.TrimSuffix(context, "\n")
+		}
+
+		if setup := fixture.filesUnder(tcCfg.Name + "/setup"); len(setup) > 0 {
+			tc.Setup = func(workDir string) {
+				for rel, data := range setup {
+					full := filepath.Join(workDir, rel)
+					if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+						continue
+					}
+					os.WriteFile(full, data, 0644) // best-effort: Setup has no error return
+				}
+			}
+		}
+
+		for _, vCfg := range tcCfg.Validators {
+			if vCfg.Type != "golden" {
+				v, err := buildValidator(vCfg, r)
+				if err != nil {
+					return nil, fmt.Errorf("%s case %s: %w", path, tcCfg.Name, err)
+				}
+				tc.Validators = append(tc.Validators, v)
+				continue
+			}
+
+			scrubbers, err := compileScrubbers(vCfg.Scrub)
+			if err != nil {
+				return nil, fmt.Errorf("%s case %s: %w", path, tcCfg.Name, err)
+			}
+			tc.Validators = append(tc.Validators, GoldenValidator(r, fixture, tcCfg.Name, vCfg.Golden, scrubbers...))
+		}
+
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	return suite, nil
+}
+
+func compileScrubbers(patterns []string) ([]*regexp.Regexp, error) {
+	var out []*regexp.Regexp
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("compiling scrub pattern %q: %w", p, err)
+		}
+		out = append(out, re)
+	}
+	return out, nil
+}
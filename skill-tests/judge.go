@@ -0,0 +1,180 @@
+package skilltests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Judge is a second, typically cheaper Claude invocation used to grade a
+// TestCase's output against a natural-language rubric, rather than a
+// literal string/regex match. TestRunner carries one shared Judge so every
+// semantic validator in a suite shares its binary, model, and timeout
+// instead of each constructing its own. Each Grade call runs its own claude
+// subprocess and is not rate limited.
+type Judge struct {
+	ClaudeBinary string        // Path to claude binary
+	Model        string        // Model to judge with, e.g. a cheaper/faster one than the model under test
+	Timeout      time.Duration // Timeout per grading call
+	DryRun       bool          // If true, use simulateGrade instead of calling Claude
+}
+
+// NewJudge creates a Judge with sensible defaults: a small, cheap model and
+// a short timeout, since grading is meant to be much cheaper than the test
+// run it grades.
+func NewJudge(dryRun bool) *Judge {
+	return &Judge{
+		ClaudeBinary: "claude",
+		Model:        "claude-3-5-haiku-20241022",
+		Timeout:      1 * time.Minute,
+		DryRun:       dryRun,
+	}
+}
+
+// JudgeResult is a judge invocation's parsed verdict.
+type JudgeResult struct {
+	Score     float64 `json:"score"`
+	Reasoning string  `json:"reasoning"`
+}
+
+// Grade asks the judge whether/how well output satisfies prompt, returning
+// its parsed score (0-1) and reasoning.
+func (j *Judge) Grade(ctx context.Context, prompt, output string) (JudgeResult, error) {
+	if j.DryRun {
+		return j.simulateGrade(prompt, output), nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, j.Timeout)
+	defer cancel()
+
+	args := []string{"--print", "--dangerously-skip-permissions"}
+	if j.Model != "" {
+		args = append(args, "--model", j.Model)
+	}
+	args = append(args, judgePrompt(prompt, output))
+
+	cmd := exec.CommandContext(ctx, j.ClaudeBinary, args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return JudgeResult{}, fmt.Errorf("judge: %w: %s", classifyClaudeError(ctx, err, stderr.String()), stderr.String())
+	}
+
+	return parseJudgeResult(stdout.String())
+}
+
+// judgePrompt wraps prompt and the output under grading into the
+// instruction the judge model sees.
+func judgePrompt(prompt, output string) string {
+	return fmt.Sprintf(
+		"%s\n\nOutput to grade:\n%s\n\nRespond with ONLY a JSON object of the form "+
+			`{"score": <number 0-1>, "reasoning": "<one sentence>"}`+", no other text.",
+		prompt, output,
+	)
+}
+
+// parseJudgeResult extracts the {score, reasoning} JSON object from a judge
+// response, tolerating leading/trailing prose the model added despite
+// being asked not to.
+func parseJudgeResult(raw string) (JudgeResult, error) {
+	start := strings.Index(raw, "{")
+	end := strings.LastIndex(raw, "}")
+	if start < 0 || end < start {
+		return JudgeResult{}, fmt.Errorf("no JSON object in judge response: %s", truncate(raw, 200))
+	}
+
+	var jr JudgeResult
+	if err := json.Unmarshal([]byte(raw[start:end+1]), &jr); err != nil {
+		return JudgeResult{}, fmt.Errorf("parse judge response: %w", err)
+	}
+	return jr, nil
+}
+
+// simulateGrade generates a deterministic mock verdict for dry-run testing,
+// so suite orchestration and scoring can be exercised without an API call.
+// Non-empty output scores well; empty output scores zero.
+func (j *Judge) simulateGrade(prompt, output string) JudgeResult {
+	if strings.TrimSpace(output) == "" {
+		return JudgeResult{Score: 0.0, Reasoning: "[DRY RUN] output is empty"}
+	}
+	return JudgeResult{Score: 0.85, Reasoning: fmt.Sprintf("[DRY RUN] simulated grade for: %s", truncate(prompt, 60))}
+}
+
+// RubricItem is one weighted criterion a Rubric validator grades.
+type RubricItem struct {
+	Criterion string
+	Weight    float64
+}
+
+// SemanticContains asks judge whether output satisfies claim, scoring the
+// judge's raw 0-1 score (so partial credit carries through) and passing
+// when that score meets threshold.
+func SemanticContains(judge *Judge, claim string, threshold float64) Validator {
+	return func(output string, _ *TestResult) Validation {
+		name := fmt.Sprintf("semantic: %s", truncate(claim, 30))
+		jr, err := judge.Grade(context.Background(), fmt.Sprintf("Does the following output satisfy this claim: %q? Score 0 (not at all) to 1 (fully).", claim), output)
+		if err != nil {
+			return Validation{Name: name, Passed: false, Score: 0.0, Message: fmt.Sprintf("judge error: %v", err)}
+		}
+		return Validation{Name: name, Passed: jr.Score >= threshold, Score: jr.Score, Message: jr.Reasoning}
+	}
+}
+
+// Rubric grades output against each weighted criterion independently and
+// combines them into a single weighted-average score.
+func Rubric(judge *Judge, criteria []RubricItem) Validator {
+	return func(output string, _ *TestResult) Validation {
+		var totalWeight, weighted float64
+		var reasons []string
+
+		for _, c := range criteria {
+			prompt := fmt.Sprintf("Grade how well the output satisfies this criterion: %q. Score 0 (not at all) to 1 (fully).", c.Criterion)
+			jr, err := judge.Grade(context.Background(), prompt, output)
+			if err != nil {
+				reasons = append(reasons, fmt.Sprintf("%s: judge error: %v", c.Criterion, err))
+				continue
+			}
+			weighted += jr.Score * c.Weight
+			totalWeight += c.Weight
+			reasons = append(reasons, fmt.Sprintf("%s: %.2f (%s)", c.Criterion, jr.Score, jr.Reasoning))
+		}
+
+		score := 0.0
+		if totalWeight > 0 {
+			score = weighted / totalWeight
+		}
+
+		return Validation{
+			Name:    "rubric",
+			Passed:  score >= 0.7,
+			Score:   score,
+			Message: strings.Join(reasons, "; "),
+		}
+	}
+}
+
+// JudgePrompt runs a fully custom judge prompt against output, optionally
+// overriding judge's model for this one call (e.g. to use a stronger model
+// for a harder judgment than the suite's default).
+func JudgePrompt(judge *Judge, prompt, judgeModel string) Validator {
+	return func(output string, _ *TestResult) Validation {
+		j := judge
+		if judgeModel != "" {
+			override := *judge
+			override.Model = judgeModel
+			j = &override
+		}
+
+		jr, err := j.Grade(context.Background(), prompt, output)
+		if err != nil {
+			return Validation{Name: "judge prompt", Passed: false, Score: 0.0, Message: fmt.Sprintf("judge error: %v", err)}
+		}
+		return Validation{Name: "judge prompt", Passed: jr.Score >= 0.7, Score: jr.Score, Message: jr.Reasoning}
+	}
+}
@@ -0,0 +1,58 @@
+package skilltests
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MateoSegura/.claude/skill-tests/analysis"
+)
+
+// Lint runs analyzers (analysis.DefaultAnalyzers if none given) against
+// skillDir's SKILL.md, rules/, and scaffolds/, returning every diagnostic
+// they report.
+func Lint(skillDir string, analyzers ...*analysis.Analyzer) ([]analysis.Diagnostic, error) {
+	if len(analyzers) == 0 {
+		analyzers = analysis.DefaultAnalyzers
+	}
+	return analysis.Lint(skillDir, analyzers...)
+}
+
+// StaticallyClean runs Lint against skillDir and fails the test if any
+// diagnostic at analysis.SeverityError fires, catching structural bugs
+// (missing frontmatter, dead scaffolds, unknown hook matchers) before ever
+// paying for an API call. Warnings are reported in the Validation message
+// but don't affect Passed.
+func StaticallyClean(skillDir string) Validator {
+	return func(_ string, _ *TestResult) Validation {
+		diags, err := Lint(skillDir)
+		if err != nil {
+			return Validation{
+				Name:    "statically clean",
+				Passed:  false,
+				Score:   0.0,
+				Message: fmt.Sprintf("lint error: %v", err),
+			}
+		}
+
+		var lines []string
+		failed := false
+		for _, d := range diags {
+			if d.Severity == analysis.SeverityError {
+				failed = true
+			}
+			lines = append(lines, fmt.Sprintf("SKILL.md:%d:%d: %s: %s: %s", d.Line, d.Column, d.Severity, d.Analyzer, d.Message))
+		}
+
+		message := "no static analysis findings"
+		if len(lines) > 0 {
+			message = strings.Join(lines, "; ")
+		}
+
+		return Validation{
+			Name:    "statically clean",
+			Passed:  !failed,
+			Score:   boolToScore(!failed),
+			Message: message,
+		}
+	}
+}
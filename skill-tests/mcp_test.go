@@ -0,0 +1,147 @@
+package skilltests
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+// checkStdioOnlyJSON validates that a ```go code block's stdout-writing
+// calls only ever emit JSON-RPC framing, not a stray fmt.Println/log
+// default writer that would corrupt the stdio transport.
+func checkStdioOnlyJSON(output string) (bool, string) {
+	if strings.Contains(output, "log.Println(") || strings.Contains(output, "log.Printf(") {
+		if !strings.Contains(output, "os.Stderr") {
+			return false, "log calls found with no explicit os.Stderr output, risking stdout corruption"
+		}
+	}
+	if regexp.MustCompile(`fmt\.Print(ln|f)?\(`).MatchString(output) &&
+		!strings.Contains(output, "os.Stdout") {
+		return false, "fmt.Print* found that isn't clearly routed through the JSON-RPC writer"
+	}
+	return true, "no non-JSON stdout writes detected"
+}
+
+// checkValidJSONSchema extracts the first {...} object following
+// "inputSchema" or "InputSchema" and checks it parses as JSON - a stand-in
+// for parsing it as JSON Schema specifically, since this package doesn't
+// carry a JSON Schema validator dependency.
+func checkValidJSONSchema(output string) (bool, string) {
+	re := regexp.MustCompile(`(?s)(?:inputSchema|InputSchema)\D*?(\{.*?\n\s*\})`)
+	m := re.FindStringSubmatch(output)
+	if m == nil {
+		return false, "no inputSchema object found"
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(m[1]), &schema); err != nil {
+		return false, "inputSchema is not valid JSON: " + err.Error()
+	}
+	if _, ok := schema["type"]; !ok {
+		return false, "inputSchema has no \"type\" field"
+	}
+	return true, "inputSchema parses as JSON with a type field"
+}
+
+// checkGracefulEOFShutdown validates that the generated server treats
+// stdin EOF as a clean shutdown rather than a fatal error.
+func checkGracefulEOFShutdown(output string) (bool, string) {
+	hasEOFCheck := strings.Contains(output, "io.EOF") || strings.Contains(output, "scanner.Err()")
+	hasNoOsExit1OnEOF := !regexp.MustCompile(`io\.EOF[\s\S]{0,40}os\.Exit\(1\)`).MatchString(output)
+	if hasEOFCheck && hasNoOsExit1OnEOF {
+		return true, "EOF handled without treating it as a fatal error"
+	}
+	return false, "no clear graceful-EOF shutdown path found"
+}
+
+// TestMCPServer tests the mcp-server-go skill.
+func TestMCPServer(t *testing.T) {
+	if os.Getenv("SKILL_TEST") == "" {
+		t.Skip("Set SKILL_TEST=1 to run skill tests (requires Claude CLI)")
+	}
+
+	runner := NewTestRunner()
+	runner.WorkDir = findProjectRoot()
+	runner.Verbose = testing.Verbose()
+
+	suite := &Suite{
+		Name:  "mcp-server-go",
+		Skill: "mcp-server-go",
+		Cases: []*TestCase{
+			{
+				Name:   "stdio-transport-correctness",
+				Skill:  "mcp-server-go",
+				Prompt: "Write a minimal Go MCP (Model Context Protocol) server over stdio. It must never write anything but JSON-RPC messages to stdout.",
+				Validators: []Validator{
+					ContainsCode("go"),
+					ContainsText("jsonrpc"),
+					ContainsText("os.Stdin"),
+					ContainsText("os.Stdout"),
+					NoErrors(),
+					CustomValidator("stdio-only-json", checkStdioOnlyJSON),
+				},
+				Iterations: 2,
+			},
+			{
+				Name:   "tool-schema-validity",
+				Skill:  "mcp-server-go",
+				Prompt: "Add a tool to the MCP server with a JSON Schema describing its input arguments, and show the tools/list handler that returns it.",
+				Validators: []Validator{
+					ContainsCode("go"),
+					ContainsText("inputSchema"),
+					ContainsText("tools/list"),
+					NoErrors(),
+					CustomValidator("valid-json-schema", checkValidJSONSchema),
+				},
+				Iterations: 2,
+			},
+			{
+				Name:   "graceful-shutdown-on-eof",
+				Skill:  "mcp-server-go",
+				Prompt: "Make sure the MCP server shuts down cleanly when the client closes stdin (EOF), instead of crashing or hanging.",
+				Validators: []Validator{
+					ContainsCode("go"),
+					ContainsText("io.EOF"),
+					NoErrors(),
+					CustomValidator("graceful-eof-shutdown", checkGracefulEOFShutdown),
+				},
+				Iterations: 2,
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	result, err := runner.RunSuite(ctx, suite)
+	if err != nil {
+		t.Fatalf("Suite execution failed: %v", err)
+	}
+
+	if err := runner.SaveSuiteResults(result, "mcp-server-results.json"); err != nil {
+		t.Logf("Warning: couldn't save results: %v", err)
+	}
+
+	t.Logf("Suite: %s", result.Name)
+	t.Logf("Tests: %d total, %d passed, %d failed", result.TotalTests, result.Passed, result.Failed)
+	t.Logf("Score: %.2f%% (Grade: %s)", result.Score*100, DefaultGradeScale().Grade(result.Score))
+	t.Logf("Duration: %v", result.Duration)
+
+	for _, r := range result.Results {
+		if !r.Passed {
+			t.Logf("FAILED: %s (iteration %d) - Score: %.2f%%", r.Name, r.Iteration, r.Score*100)
+			for _, v := range r.Validations {
+				if !v.Passed {
+					t.Logf("  - %s: %s", v.Name, v.Message)
+				}
+			}
+		}
+	}
+
+	if result.Score < 0.70 {
+		t.Errorf("Suite score %.2f%% is below 70%% threshold", result.Score*100)
+	}
+}
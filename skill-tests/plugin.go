@@ -0,0 +1,141 @@
+package skilltests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// PluginRequest is what TestRunner writes to a validator plugin's stdin:
+// the case's prompt and captured output, plus whatever config the
+// TestCase.Validators entry attached via PluginValidator.
+type PluginRequest struct {
+	Name   string                 `json:"name"`
+	Prompt string                 `json:"prompt"`
+	Output string                 `json:"output"`
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+// PluginResponse is what a validator plugin writes back to stdout: one
+// JSON object mirroring Validation, plus Suggestions for remediation hints
+// a closure-based Validator has no channel to surface.
+type PluginResponse struct {
+	Name        string   `json:"name"`
+	Passed      bool     `json:"passed"`
+	Score       float64  `json:"score"`
+	Message     string   `json:"message"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// RegisterValidatorPlugin maps name to the executable at path, so
+// PluginValidator(r, name, ...) can find it. Call DiscoverValidatorPlugins
+// to register every executable under .claude/validators/ instead of doing
+// this one at a time.
+func (r *TestRunner) RegisterValidatorPlugin(name, path string) {
+	if r.ValidatorPlugins == nil {
+		r.ValidatorPlugins = make(map[string]string)
+	}
+	r.ValidatorPlugins[name] = path
+}
+
+// DiscoverValidatorPlugins registers every executable file directly under
+// dir (typically WorkDir/.claude/validators) as a plugin named after its
+// filename, so a team can drop in a domain-specific checker without
+// recompiling the test suite or editing Go source to register it.
+func (r *TestRunner) DiscoverValidatorPlugins(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("reading validator plugin dir %s: %w", dir, err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.Mode()&0111 == 0 {
+			continue
+		}
+		name := entry.Name()
+		r.RegisterValidatorPlugin(strings.TrimSuffix(name, filepath.Ext(name)), filepath.Join(dir, name))
+	}
+	return nil
+}
+
+// discoverValidatorPluginsOnce auto-registers every executable under
+// WorkDir/.claude/validators the first time a plugin is looked up, so a
+// suite gets auto-discovery for free just by setting WorkDir (the way
+// skills under WorkDir/.claude/skills are already discovered implicitly).
+// It's a no-op once ValidatorPlugins has been populated, whether by this
+// or by an explicit RegisterValidatorPlugin call.
+func (r *TestRunner) discoverValidatorPluginsOnce() {
+	if r.ValidatorPlugins != nil || r.WorkDir == "" {
+		return
+	}
+	r.ValidatorPlugins = make(map[string]string)
+	if err := r.DiscoverValidatorPlugins(filepath.Join(r.WorkDir, ".claude", "validators")); err != nil {
+		fmt.Fprintf(os.Stderr, "discovering validator plugins: %v\n", err)
+	}
+}
+
+// PluginValidator resolves name against r.ValidatorPlugins and runs it as a
+// subprocess for each output it validates: the request is written to
+// stdin as JSON, the response read back from stdout the same way, modeled
+// on operator-sdk scorecard's test plugin protocol. This lets a check live
+// as a standalone executable - a JSON-schema validator, a go vet pass over
+// extracted code, a Markdown-link checker - instead of a closure compiled
+// into this package.
+func PluginValidator(r *TestRunner, name string, config map[string]interface{}) Validator {
+	return func(output string, result *TestResult) Validation {
+		vname := fmt.Sprintf("plugin: %s", name)
+
+		r.discoverValidatorPluginsOnce()
+		path, ok := r.ValidatorPlugins[name]
+		if !ok {
+			return Validation{Name: vname, Passed: false, Message: fmt.Sprintf("no validator plugin registered as %q", name)}
+		}
+
+		req := PluginRequest{Name: name, Output: output, Config: config}
+		if result != nil {
+			req.Prompt = result.Prompt
+		}
+		reqBody, err := json.Marshal(req)
+		if err != nil {
+			return Validation{Name: vname, Passed: false, Message: fmt.Sprintf("marshaling plugin request: %v", err)}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		cmd := exec.CommandContext(ctx, path)
+		cmd.Stdin = bytes.NewReader(reqBody)
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		if err := cmd.Run(); err != nil {
+			return Validation{Name: vname, Passed: false, Message: fmt.Sprintf("running plugin %s: %v: %s", path, err, stderr.String())}
+		}
+
+		var resp PluginResponse
+		if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+			return Validation{Name: vname, Passed: false, Message: fmt.Sprintf("parsing plugin response: %v: %s", err, stdout.String())}
+		}
+
+		message := resp.Message
+		if len(resp.Suggestions) > 0 {
+			message = fmt.Sprintf("%s (suggestions: %s)", message, strings.Join(resp.Suggestions, "; "))
+		}
+
+		return Validation{Name: vname, Passed: resp.Passed, Score: resp.Score, Message: message}
+	}
+}
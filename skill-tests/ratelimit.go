@@ -0,0 +1,74 @@
+package skilltests
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a simple token-bucket limiter capping calls to perMinute
+// per minute, shared across RunSuite's workers so a parallel suite can't
+// blow past Anthropic's rate limits. A nil *rateLimiter (perMinute <= 0)
+// disables limiting.
+type rateLimiter struct {
+	mu       sync.Mutex
+	tokens   float64
+	max      float64
+	perSec   float64
+	lastFill time.Time
+}
+
+// newRateLimiter creates a rateLimiter capped at perMinute calls/minute, or
+// returns nil if perMinute <= 0.
+func newRateLimiter(perMinute int) *rateLimiter {
+	if perMinute <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		tokens:   float64(perMinute),
+		max:      float64(perMinute),
+		perSec:   float64(perMinute) / 60.0,
+		lastFill: time.Now(),
+	}
+}
+
+// Wait blocks until a token is available (or ctx is done), then consumes
+// one. A nil limiter never blocks.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	for {
+		wait, ok := l.take()
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// take refills tokens for elapsed time and, if one is available, consumes
+// it and returns (0, true). Otherwise it returns how long to wait before
+// trying again.
+func (l *rateLimiter) take() (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens = math.Min(l.max, l.tokens+now.Sub(l.lastFill).Seconds()*l.perSec)
+	l.lastFill = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0, true
+	}
+
+	return time.Duration((1 - l.tokens) / l.perSec * float64(time.Second)), false
+}
@@ -0,0 +1,57 @@
+package skilltests
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimiterDisabled(t *testing.T) {
+	if l := newRateLimiter(0); l != nil {
+		t.Errorf("newRateLimiter(0) = %v, want nil", l)
+	}
+	if l := newRateLimiter(-5); l != nil {
+		t.Errorf("newRateLimiter(-5) = %v, want nil", l)
+	}
+}
+
+func TestRateLimiterTakeExhaustsBucket(t *testing.T) {
+	l := newRateLimiter(60) // 1 token/sec, starts full at 60
+
+	for i := 0; i < 60; i++ {
+		if _, ok := l.take(); !ok {
+			t.Fatalf("take() #%d returned false before the bucket should be exhausted", i)
+		}
+	}
+
+	wait, ok := l.take()
+	if ok {
+		t.Fatal("take() on an exhausted bucket returned true, want false")
+	}
+	if wait <= 0 {
+		t.Errorf("take() wait duration = %v, want a positive wait", wait)
+	}
+}
+
+func TestRateLimiterWaitNilNeverBlocks(t *testing.T) {
+	var l *rateLimiter
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); err != nil {
+		t.Errorf("nil rateLimiter.Wait() = %v, want nil", err)
+	}
+}
+
+func TestRateLimiterWaitRespectsContextCancellation(t *testing.T) {
+	l := newRateLimiter(1) // 1 token/min - the second take will have to wait ~1 minute
+	l.tokens = 0           // force the bucket empty so Wait must actually block
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := l.Wait(ctx)
+	if err == nil {
+		t.Fatal("Wait() on an exhausted limiter with a short deadline = nil, want a context error")
+	}
+}
@@ -0,0 +1,419 @@
+package skilltests
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"html/template"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// sarifLog is the root of a SARIF 2.1.0 log, trimmed to the fields this
+// package populates. See https://docs.oasis-open.org/sarif/sarif/v2.1.0/.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+// sarifRule is one Validator, identified by Validation.Name. Rules are
+// deduplicated across all TestResults in a suite so a validator that runs
+// against several test cases still contributes a single rule.
+type sarifRule struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"` // "error" or "warning"
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// SaveSARIF saves result as a SARIF 2.1.0 log: one rule per named Validator
+// and one result per failed Validation, so suite runs can surface in GitHub
+// Actions/GitLab/Jenkins code-scanning UIs instead of requiring ad-hoc
+// parsing of the plain JSON from SaveSuiteResults. A failed validation is
+// leveled "error" when its score falls below DefaultGradeScale's D
+// threshold, "warning" otherwise.
+func (r *TestRunner) SaveSARIF(result *SuiteResult, filename string) error {
+	scale := DefaultGradeScale()
+
+	rules := []sarifRule{}
+	seen := make(map[string]bool)
+	var results []sarifResult
+
+	for _, tr := range result.Results {
+		location := sarifLocation{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{
+					URI: filepath.Join(".claude", "skills", tr.Skill, "SKILL.md"),
+				},
+			},
+		}
+
+		for _, v := range tr.Validations {
+			if !seen[v.Name] {
+				seen[v.Name] = true
+				rules = append(rules, sarifRule{ID: v.Name, Name: v.Name})
+			}
+			if v.Passed {
+				continue
+			}
+
+			level := "warning"
+			if v.Score < scale.D {
+				level = "error"
+			}
+
+			results = append(results, sarifResult{
+				RuleID:    v.Name,
+				Level:     level,
+				Message:   sarifMessage{Text: v.Message},
+				Locations: []sarifLocation{location},
+			})
+		}
+	}
+
+	log := sarifLog{
+		Schema:  "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:  "skilltests",
+						Rules: rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+
+	data, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(r.OutputDir, filename), data, 0644)
+}
+
+// junitTestSuites is the root <testsuites> element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Time      float64         `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// SaveJUnit saves result as a JUnit XML report: one testsuite per suite run
+// and one testcase per TestResult, failed when any Validation failed. This
+// is the other half of SaveSARIF's CI-surfacing story, for runners (Jenkins,
+// GitLab) that consume JUnit rather than SARIF.
+func (r *TestRunner) SaveJUnit(result *SuiteResult, filename string) error {
+	suite := junitTestSuite{
+		Name:     result.Name,
+		Tests:    result.TotalTests,
+		Failures: result.Failed,
+		Time:     result.Duration.Seconds(),
+	}
+
+	for _, tr := range result.Results {
+		tc := junitTestCase{
+			Name:      fmt.Sprintf("%s#%d", tr.Name, tr.Iteration),
+			ClassName: tr.Skill,
+			Time:      tr.Duration.Seconds(),
+		}
+		if !tr.Passed {
+			tc.Failure = &junitFailure{
+				Message: fmt.Sprintf("score %.2f below passing threshold", tr.Score),
+				Text:    junitFailureText(tr),
+			}
+		}
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	doc := junitTestSuites{Suites: []junitTestSuite{suite}}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append([]byte(xml.Header), data...)
+	return os.WriteFile(filepath.Join(r.OutputDir, filename), data, 0644)
+}
+
+// junitFailureText summarizes a failed TestResult's validations for a JUnit
+// <failure> body.
+func junitFailureText(tr *TestResult) string {
+	text := ""
+	for _, v := range tr.Validations {
+		if v.Passed {
+			continue
+		}
+		text += fmt.Sprintf("%s: %s\n", v.Name, v.Message)
+	}
+	return text
+}
+
+// ValidatorFailureStat summarizes how often one named Validator failed
+// across every TestResult in a suite, so a flaky or overly strict validator
+// stands out in a report instead of being buried in per-case output.
+type ValidatorFailureStat struct {
+	Name     string  `json:"name"`
+	Failures int     `json:"failures"`
+	Total    int     `json:"total"`
+	FailRate float64 `json:"fail_rate"`
+}
+
+// SuiteReport wraps a SuiteResult with the breakdown a text summary doesn't
+// have room for: a letter grade, which Validators failed most often, and
+// per-TestCase score variance across Iterations - flaky LLM validators show
+// up here as a test case with a low score but high variance, rather than
+// looking identical to a consistently-bad one.
+type SuiteReport struct {
+	*SuiteResult
+	Grade             string                 `json:"grade"`
+	ValidatorFailures []ValidatorFailureStat `json:"validator_failures"`
+	Variance          map[string]float64     `json:"variance"` // TestCase name -> score variance across its iterations
+}
+
+// TestReport aggregates every SuiteReport from a single run-tests
+// invocation, plus the totals cmd/run-tests otherwise has to re-derive by
+// summing SuiteResults itself.
+type TestReport struct {
+	Suites     []SuiteReport `json:"suites"`
+	TotalTests int           `json:"total_tests"`
+	Passed     int           `json:"passed"`
+	Failed     int           `json:"failed"`
+	Score      float64       `json:"score"`
+}
+
+// BuildReport turns the raw SuiteResults a test run produced into a
+// TestReport: one SuiteReport per suite, graded with scale, plus the
+// totals across all of them.
+func BuildReport(results []*SuiteResult, scale GradeScale) *TestReport {
+	report := &TestReport{}
+
+	totalScore := 0.0
+	for _, res := range results {
+		sr := SuiteReport{
+			SuiteResult:       res,
+			Grade:             scale.Grade(res.Score),
+			ValidatorFailures: validatorFailureStats(res),
+			Variance:          scoreVariance(res),
+		}
+		report.Suites = append(report.Suites, sr)
+
+		report.TotalTests += res.TotalTests
+		report.Passed += res.Passed
+		report.Failed += res.Failed
+		totalScore += res.Score * float64(res.TotalTests)
+	}
+
+	if report.TotalTests > 0 {
+		report.Score = totalScore / float64(report.TotalTests)
+	}
+
+	return report
+}
+
+// validatorFailureStats counts, per named Validator, how many times it ran
+// and how many of those runs failed across every TestResult in result.
+func validatorFailureStats(result *SuiteResult) []ValidatorFailureStat {
+	stats := make(map[string]*ValidatorFailureStat)
+	var order []string
+
+	for _, tr := range result.Results {
+		for _, v := range tr.Validations {
+			s, ok := stats[v.Name]
+			if !ok {
+				s = &ValidatorFailureStat{Name: v.Name}
+				stats[v.Name] = s
+				order = append(order, v.Name)
+			}
+			s.Total++
+			if !v.Passed {
+				s.Failures++
+			}
+		}
+	}
+
+	out := make([]ValidatorFailureStat, 0, len(order))
+	for _, name := range order {
+		s := stats[name]
+		if s.Total > 0 {
+			s.FailRate = float64(s.Failures) / float64(s.Total)
+		}
+		out = append(out, *s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Failures > out[j].Failures })
+	return out
+}
+
+// scoreVariance computes the population variance of Score across a
+// TestCase's Iterations, keyed by TestResult.Name. A case run once has no
+// meaningful variance and is omitted.
+func scoreVariance(result *SuiteResult) map[string]float64 {
+	scores := make(map[string][]float64)
+	var order []string
+	for _, tr := range result.Results {
+		if _, ok := scores[tr.Name]; !ok {
+			order = append(order, tr.Name)
+		}
+		scores[tr.Name] = append(scores[tr.Name], tr.Score)
+	}
+
+	variance := make(map[string]float64)
+	for _, name := range order {
+		vals := scores[name]
+		if len(vals) < 2 {
+			continue
+		}
+
+		mean := 0.0
+		for _, v := range vals {
+			mean += v
+		}
+		mean /= float64(len(vals))
+
+		sumSq := 0.0
+		for _, v := range vals {
+			sumSq += (v - mean) * (v - mean)
+		}
+		variance[name] = math.Round(sumSq/float64(len(vals))*10000) / 10000
+	}
+	return variance
+}
+
+// SaveReport saves report as indented JSON under r.OutputDir on the OS
+// filesystem (not through r.FS), the machine-readable counterpart to the
+// colorized text summary cmd/run-tests prints to stdout.
+func (r *TestRunner) SaveReport(report *TestReport, filename string) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(r.OutputDir, filename), data, 0644)
+}
+
+// htmlReportTemplate renders one grade card per suite: its letter grade,
+// which validators failed most often, and which test cases show high
+// score variance across their iterations (a sign of a flaky validator or a
+// flaky skill, rather than a consistently failing one).
+var htmlReportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"mulf": func(a, b float64) float64 { return a * b },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Skill Test Report</title>
+<style>
+  body { font-family: -apple-system, sans-serif; background: #0a0a0a; color: #e5e5e5; margin: 2rem; }
+  .card { background: #1a1a1a; border: 1px solid #333; border-radius: 8px; padding: 1.5rem; margin-bottom: 1.5rem; }
+  .grade { font-size: 2.5rem; font-weight: bold; float: right; }
+  .grade-A, .grade-B { color: #39FF14; }
+  .grade-C, .grade-D { color: #FFD700; }
+  .grade-F { color: #FF073A; }
+  table { width: 100%; border-collapse: collapse; margin-top: 1rem; }
+  th, td { text-align: left; padding: 0.4rem 0.6rem; border-bottom: 1px solid #333; }
+  th { color: #999; font-weight: normal; }
+</style>
+</head>
+<body>
+<h1>Skill Test Report</h1>
+<p>{{.Passed}} passed, {{.Failed}} failed, {{.TotalTests}} total, {{printf "%.1f" (mulf .Score 100)}}% average score</p>
+{{range .Suites}}
+<div class="card">
+  <span class="grade grade-{{.Grade}}">{{.Grade}}</span>
+  <h2>{{.Name}}</h2>
+  <p>{{.Passed}} / {{.TotalTests}} passed &middot; {{printf "%.1f" (mulf .Score 100)}}% average score</p>
+
+  {{if .ValidatorFailures}}
+  <h3>Validator failures</h3>
+  <table>
+    <tr><th>Validator</th><th>Failures</th><th>Total</th><th>Fail rate</th></tr>
+    {{range .ValidatorFailures}}
+    <tr><td>{{.Name}}</td><td>{{.Failures}}</td><td>{{.Total}}</td><td>{{printf "%.0f" (mulf .FailRate 100)}}%</td></tr>
+    {{end}}
+  </table>
+  {{end}}
+
+  {{if .Variance}}
+  <h3>Score variance across iterations</h3>
+  <table>
+    <tr><th>Test case</th><th>Variance</th></tr>
+    {{range $name, $v := .Variance}}
+    <tr><td>{{$name}}</td><td>{{printf "%.4f" $v}}</td></tr>
+    {{end}}
+  </table>
+  {{end}}
+</div>
+{{end}}
+</body>
+</html>
+`))
+
+// SaveHTML renders report as a per-suite grade-card HTML page under
+// r.OutputDir on the OS filesystem (not through r.FS).
+func (r *TestRunner) SaveHTML(report *TestReport, filename string) error {
+	f, err := os.Create(filepath.Join(r.OutputDir, filename))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return htmlReportTemplate.Execute(f, report)
+}
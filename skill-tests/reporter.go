@@ -0,0 +1,207 @@
+package skilltests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// Reporter renders RunSuite's progress and outcome, replacing the
+// per-file t.Logf blocks every *_test.go suite used to hand-roll. RunSuite
+// calls CaseResult as each TestResult lands and Summary once at the end;
+// a nil TestRunner.Reporter (the zero value) skips both, leaving today's
+// t.Logf-only suites unaffected.
+type Reporter interface {
+	// CaseResult is called once per completed TestCase iteration, as soon
+	// as its TestResult is available - before the rest of the suite has
+	// necessarily finished.
+	CaseResult(result *TestResult)
+	// Summary is called once, after every case in the suite has completed.
+	Summary(result *SuiteResult)
+}
+
+// ColorReporter renders CaseResult/Summary as colorized lines through the
+// active theme.Theme (theme.Default() if unset), the same palette
+// cmd/run-tests' printSuiteSummary and Watcher already render through.
+type ColorReporter struct {
+	Out   io.Writer
+	Theme *theme.Theme
+}
+
+// NewColorReporter creates a ColorReporter writing to os.Stdout with the
+// default theme.
+func NewColorReporter() *ColorReporter {
+	return &ColorReporter{Out: os.Stdout, Theme: theme.Default()}
+}
+
+func (c *ColorReporter) out() io.Writer {
+	if c.Out == nil {
+		return os.Stdout
+	}
+	return c.Out
+}
+
+func (c *ColorReporter) theme() *theme.Theme {
+	if c.Theme == nil {
+		return theme.Default()
+	}
+	return c.Theme
+}
+
+// CaseResult implements Reporter: a green check for a clean pass, a
+// yellow circle for a pass that didn't earn full score, a red cross for a
+// failure, followed by a colorized score bar.
+func (c *ColorReporter) CaseResult(result *TestResult) {
+	t := c.theme()
+
+	mark, color := t.Icons.Cross, t.Error
+	switch {
+	case result.Passed && result.Score >= 0.999:
+		mark, color = t.Icons.Check, t.Success
+	case result.Passed:
+		mark, color = t.Icons.CircleO, t.Primary
+	}
+
+	style := lipgloss.NewStyle().Foreground(color)
+	fmt.Fprintf(c.out(), "%s %s (iter %d) %s\n", style.Render(mark), result.Name, result.Iteration, style.Render(scoreBar(result.Score, 20)))
+}
+
+// Summary implements Reporter: totals, pass rate, mean score, and every
+// failing case's failing validator names, so a glance at the end of a
+// suite run says what to go look at without re-reading every CaseResult
+// line above it.
+func (c *ColorReporter) Summary(result *SuiteResult) {
+	t := c.theme()
+	out := c.out()
+
+	passRate := 0.0
+	if result.TotalTests > 0 {
+		passRate = float64(result.Passed) / float64(result.TotalTests) * 100
+	}
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(t.Primary)
+	fmt.Fprintln(out, headerStyle.Render(fmt.Sprintf("── %s (%s) ──", result.Name, result.Skill)))
+	fmt.Fprintf(out, "  %d/%d passed (%.1f%%), mean score %.2f%%, duration %v\n",
+		result.Passed, result.TotalTests, passRate, result.Score*100, result.Duration)
+
+	for _, r := range result.Results {
+		if r.Passed {
+			continue
+		}
+		errStyle := lipgloss.NewStyle().Foreground(t.Error)
+		var failing []string
+		for _, v := range r.Validations {
+			if !v.Passed {
+				failing = append(failing, v.Name)
+			}
+		}
+		fmt.Fprintln(out, errStyle.Render(fmt.Sprintf("  ✗ %s (iter %d): %v", r.Name, r.Iteration, failing)))
+	}
+}
+
+// scoreBar renders score (0-1) as a width-wide bar of "#"/".", for a
+// compact visual alongside each CaseResult line.
+func scoreBar(score float64, width int) string {
+	filled := int(score*float64(width) + 0.5)
+	if filled > width {
+		filled = width
+	}
+	if filled < 0 {
+		filled = 0
+	}
+	bar := make([]byte, width)
+	for i := range bar {
+		if i < filled {
+			bar[i] = '#'
+		} else {
+			bar[i] = '.'
+		}
+	}
+	return string(bar)
+}
+
+// PlainReporter renders the same information as ColorReporter without any
+// styling, for CI logs and terminals that don't handle ANSI well.
+type PlainReporter struct {
+	Out io.Writer
+}
+
+func (p *PlainReporter) out() io.Writer {
+	if p.Out == nil {
+		return os.Stdout
+	}
+	return p.Out
+}
+
+// CaseResult implements Reporter.
+func (p *PlainReporter) CaseResult(result *TestResult) {
+	status := "FAIL"
+	if result.Passed {
+		status = "PASS"
+	}
+	fmt.Fprintf(p.out(), "[%s] %s (iter %d) score=%.2f\n", status, result.Name, result.Iteration, result.Score)
+}
+
+// Summary implements Reporter.
+func (p *PlainReporter) Summary(result *SuiteResult) {
+	out := p.out()
+	fmt.Fprintf(out, "Suite: %s\n", result.Name)
+	fmt.Fprintf(out, "Tests: %d total, %d passed, %d failed\n", result.TotalTests, result.Passed, result.Failed)
+	fmt.Fprintf(out, "Score: %.2f%% duration=%v\n", result.Score*100, result.Duration)
+
+	for _, r := range result.Results {
+		if r.Passed {
+			continue
+		}
+		fmt.Fprintf(out, "FAILED: %s (iteration %d) - score %.2f%%\n", r.Name, r.Iteration, r.Score*100)
+		for _, v := range r.Validations {
+			if !v.Passed {
+				fmt.Fprintf(out, "  - %s: %s\n", v.Name, v.Message)
+			}
+		}
+	}
+}
+
+// JSONReporter emits one JSON object per CaseResult and one for Summary,
+// newline-delimited, for a CI pipeline that wants to stream results into
+// its own log aggregator rather than parse colorized text.
+type JSONReporter struct {
+	Enc *json.Encoder
+}
+
+// NewJSONReporter creates a JSONReporter writing to os.Stdout.
+func NewJSONReporter() *JSONReporter {
+	return &JSONReporter{Enc: json.NewEncoder(os.Stdout)}
+}
+
+// CaseResult implements Reporter.
+func (j *JSONReporter) CaseResult(result *TestResult) {
+	j.Enc.Encode(result)
+}
+
+// Summary implements Reporter.
+func (j *JSONReporter) Summary(result *SuiteResult) {
+	j.Enc.Encode(result)
+}
+
+// ReporterByName resolves a -reporter flag value ("color", "plain",
+// "json") to a Reporter, for cmd/run-tests. "tui" isn't handled here: it
+// needs a live bubbletea program, which cmd/run-tests builds with
+// dashboard.NewLiveTUIReporter from skill-tests/dashboard.
+func ReporterByName(name string) (Reporter, error) {
+	switch name {
+	case "", "color":
+		return NewColorReporter(), nil
+	case "plain":
+		return &PlainReporter{}, nil
+	case "json":
+		return NewJSONReporter(), nil
+	default:
+		return nil, fmt.Errorf("unknown reporter %q (want color, plain, json, or tui)", name)
+	}
+}
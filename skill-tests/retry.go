@@ -0,0 +1,91 @@
+package skilltests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// RequeueError signals that a Claude CLI invocation failed transiently -
+// a rate limit, a 5xx from the API, or a context timeout - and should be
+// retried after a delay rather than counted as a test failure.
+type RequeueError struct {
+	After  time.Duration
+	Reason string
+}
+
+// Error implements error.
+func (e *RequeueError) Error() string {
+	return fmt.Sprintf("requeue after %s: %s", e.After, e.Reason)
+}
+
+// IsRequeue reports whether err wraps a *RequeueError, unwrapping it via
+// errors.As.
+func IsRequeue(err error) (RequeueError, bool) {
+	var re *RequeueError
+	if errors.As(err, &re) {
+		return *re, true
+	}
+	return RequeueError{}, false
+}
+
+// classifyClaudeError turns a known-transient claude CLI failure into a
+// RequeueError so runClaudeWithRetry can retry it, leaving anything else
+// (a real validation or usage error) unchanged.
+func classifyClaudeError(ctx context.Context, err error, stderr string) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return &RequeueError{After: 5 * time.Second, Reason: "context timeout"}
+	}
+
+	lower := strings.ToLower(stderr)
+	switch {
+	case strings.Contains(lower, "rate_limit") || strings.Contains(lower, "429"):
+		return &RequeueError{After: 30 * time.Second, Reason: "rate limited"}
+	case strings.Contains(lower, "overloaded") || strings.Contains(lower, "529"):
+		return &RequeueError{After: 15 * time.Second, Reason: "overloaded"}
+	case strings.Contains(lower, "internal_server_error") || strings.Contains(lower, "500") ||
+		strings.Contains(lower, "502") || strings.Contains(lower, "503"):
+		return &RequeueError{After: 10 * time.Second, Reason: "server error"}
+	default:
+		return err
+	}
+}
+
+// runClaudeWithRetry calls runClaude, retrying up to tc.MaxRetries times
+// when it returns a RequeueError, sleeping After with exponential backoff
+// and jitter between attempts. result.Attempts tracks how many attempts
+// were made. Validators only ever see the final successful output: a
+// requeued attempt's output is discarded here, never assigned to result,
+// so a partial response from a retried attempt can't leak into scoring.
+func (r *TestRunner) runClaudeWithRetry(ctx context.Context, workDir string, tc *TestCase, result *TestResult) (string, error) {
+	var lastErr error
+
+	for attempt := 0; ; attempt++ {
+		result.Attempts = attempt + 1
+
+		output, err := r.runClaude(ctx, workDir, tc.Skill, tc.Prompt, tc.Context)
+		if err == nil {
+			return output, nil
+		}
+		lastErr = err
+
+		rq, ok := IsRequeue(err)
+		if !ok || attempt >= tc.MaxRetries {
+			return "", lastErr
+		}
+
+		delay := rq.After*time.Duration(1<<attempt) + time.Duration(rand.Int63n(int64(rq.After)+1))
+		if r.Verbose {
+			fmt.Printf("requeue %s (attempt %d/%d): %s, retrying in %s\n", tc.Name, attempt+1, tc.MaxRetries+1, rq.Reason, delay)
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package skilltests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestClassifyClaudeError(t *testing.T) {
+	plainErr := errors.New("some unrelated failure")
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		err        error
+		stderr     string
+		wantReason string // "" means classifyClaudeError should return err unchanged
+	}{
+		{"context deadline exceeded", deadlineExceededContext(), plainErr, "", "context timeout"},
+		{"rate limited by phrase", context.Background(), plainErr, "Error: rate_limit exceeded", "rate limited"},
+		{"rate limited by status code", context.Background(), plainErr, "HTTP 429 Too Many Requests", "rate limited"},
+		{"overloaded", context.Background(), plainErr, "service overloaded, try again", "overloaded"},
+		{"server error 5xx", context.Background(), plainErr, "received 503 from upstream", "server error"},
+		{"unrelated error passes through", context.Background(), plainErr, "invalid prompt: missing required field", ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := classifyClaudeError(tc.ctx, tc.err, tc.stderr)
+
+			if tc.wantReason == "" {
+				if got != tc.err {
+					t.Errorf("classifyClaudeError() = %v, want the original error unchanged", got)
+				}
+				return
+			}
+
+			rq, ok := IsRequeue(got)
+			if !ok {
+				t.Fatalf("classifyClaudeError() = %v, want a *RequeueError", got)
+			}
+			if rq.Reason != tc.wantReason {
+				t.Errorf("RequeueError.Reason = %q, want %q", rq.Reason, tc.wantReason)
+			}
+		})
+	}
+}
+
+func TestIsRequeue(t *testing.T) {
+	if _, ok := IsRequeue(errors.New("plain error")); ok {
+		t.Error("IsRequeue(plain error) = true, want false")
+	}
+	if _, ok := IsRequeue(nil); ok {
+		t.Error("IsRequeue(nil) = true, want false")
+	}
+
+	wrapped := &RequeueError{After: time.Second, Reason: "rate limited"}
+	if rq, ok := IsRequeue(wrapped); !ok || rq.Reason != "rate limited" {
+		t.Errorf("IsRequeue(%v) = (%v, %v), want (Reason: rate limited, true)", wrapped, rq, ok)
+	}
+}
+
+func deadlineExceededContext() context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	<-ctx.Done()
+	return ctx
+}
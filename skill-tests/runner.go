@@ -7,12 +7,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/spf13/afero"
 )
 
 // TestRunner executes skill tests against Claude CLI.
@@ -23,10 +27,55 @@ type TestRunner struct {
 	Timeout      time.Duration // Timeout per test
 	Verbose      bool          // Print detailed output
 	DryRun       bool          // If true, validate structure without calling Claude
+	UpdateGolden bool          // If true, GoldenValidator rewrites its fixture's golden section instead of comparing against it
+	FS           afero.Fs      // Filesystem for workspaces/output (default afero.NewOsFs())
+	Judge        *Judge        // Shared judge client for semantic validators (SemanticContains, Rubric, JudgePrompt, RuleFollowed)
+
+	// ValidatorPlugins maps a plugin name to its executable path, for
+	// PluginValidator. Populate via RegisterValidatorPlugin or
+	// DiscoverValidatorPlugins rather than setting directly.
+	ValidatorPlugins map[string]string
+
+	// Reporter renders RunSuite's per-case and summary output, if set.
+	// Left nil, RunSuite reports nothing itself - the existing *_test.go
+	// suites' own t.Logf reporting still works unchanged.
+	Reporter Reporter
+
+	Parallelism   int // Worker pool size for RunSuite (0 or 1 = sequential)
+	RatePerMinute int // Cap on Claude invocations/minute across RunSuite's workers (0 = unlimited)
+
+	// DefaultMinScore backs TestCase.MinScore when a case leaves it at 0,
+	// letting a suite set one pass/fail bar for every case (Suite.MinScore,
+	// applied by RunSuite) without every TestCase repeating it. Falls back
+	// to 0.70 when both are 0.
+	DefaultMinScore float64
+
+	// CIFloor is the Wilson lower-bound CaseStats.BelowCIFloor checks
+	// against, filled in from Suite.CIFloor the same way DefaultMinScore is
+	// filled in from Suite.MinScore. Falls back to 0.40 when both are 0, so
+	// a case passing on a small, lucky sample of Iterations still gets
+	// flagged even though its raw PassRate clears MinScore.
+	CIFloor float64
+
+	// Baseline, when non-nil, is consulted and updated by RunSuite; left
+	// nil, it's lazily loaded from BaselinePath the first time RunSuite
+	// needs it. RegressionDelta and BaselineUpdate govern how RunSuite uses
+	// it - see baseline.go.
+	Baseline        *BaselineStore
+	BaselinePath    string  // Defaults to "results/baseline.json"
+	RegressionDelta float64 // Pass-rate drop that counts as a regression (0 = default 0.20)
+	BaselineUpdate  bool    // If true, RunSuite snapshots this run as the new baseline instead of just comparing against it
+
+	progressCh chan ProgressEvent
 }
 
 // NewTestRunner creates a runner with default settings.
-// Automatically enables DryRun mode if ANTHROPIC_API_KEY is not set.
+// Automatically enables DryRun mode if ANTHROPIC_API_KEY is not set,
+// UpdateGolden if UPDATE_GOLDEN is set, BaselineUpdate if BASELINE_UPDATE is
+// set, and a Reporter from REPORTER (color by default), so a `go test`
+// subprocess launched by cmd/run-tests (which sets all of these as env
+// vars) picks them up without every hand-written suite needing its own flag
+// parsing.
 func NewTestRunner() *TestRunner {
 	dryRun := os.Getenv("ANTHROPIC_API_KEY") == ""
 	if dryRun {
@@ -34,40 +83,104 @@ func NewTestRunner() *TestRunner {
 		fmt.Println("   To run full tests: export ANTHROPIC_API_KEY=your-key")
 		fmt.Println()
 	}
+	reporter, err := ReporterByName(os.Getenv("REPORTER"))
+	if err != nil {
+		reporter = NewColorReporter()
+	}
+	return &TestRunner{
+		ClaudeBinary:   "claude",
+		WorkDir:        ".",
+		OutputDir:      "/tmp/skill-tests",
+		Timeout:        5 * time.Minute,
+		Verbose:        false,
+		DryRun:         dryRun,
+		UpdateGolden:   os.Getenv("UPDATE_GOLDEN") != "",
+		FS:             afero.NewOsFs(),
+		Judge:          NewJudge(dryRun),
+		Reporter:       reporter,
+		BaselinePath:   "results/baseline.json",
+		BaselineUpdate: os.Getenv("BASELINE_UPDATE") != "",
+	}
+}
+
+// NewMemTestRunner creates a runner backed entirely by an in-memory
+// afero.MemMapFs, for unit-testing validators and suite orchestration
+// without touching /tmp/skill-tests or shelling out to the claude binary.
+// DryRun is forced true: the real CLI needs a real directory to run in, so
+// a memory-backed runner can only ever exercise simulateResponse.
+func NewMemTestRunner() *TestRunner {
 	return &TestRunner{
 		ClaudeBinary: "claude",
 		WorkDir:      ".",
 		OutputDir:    "/tmp/skill-tests",
 		Timeout:      5 * time.Minute,
-		Verbose:      false,
-		DryRun:       dryRun,
+		DryRun:       true,
+		FS:           afero.NewMemMapFs(),
+		Judge:        NewJudge(true),
 	}
 }
 
+// EnableCopyOnWrite wraps r.FS in a copy-on-write overlay: reads fall
+// through to the current r.FS (skill sources under r.WorkDir, read-only),
+// while every write - creating a test workspace, copying a skill into it,
+// saving output - lands in a fresh in-memory layer instead. Suites that run
+// the same skill across many TestCase.Iterations stop paying for a real
+// copyDir onto disk every iteration. copyDir still reads every source file
+// from disk on each copy.
+func (r *TestRunner) EnableCopyOnWrite() {
+	r.FS = afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(r.FS), afero.NewMemMapFs())
+}
+
 // TestCase defines a single skill test.
 type TestCase struct {
-	Name        string                 // Test name
-	Skill       string                 // Skill to load
-	Prompt      string                 // Task to give Claude
-	Context     string                 // Additional context
-	Validators  []Validator            // Functions to validate output
-	Setup       func(workDir string)   // Optional setup function
-	Teardown    func(workDir string)   // Optional teardown function
-	Expected    map[string]interface{} // Expected values for structured validation
-	Iterations  int                    // Number of times to run (for consistency testing)
+	Name       string                 // Test name
+	Skill      string                 // Skill to load
+	Prompt     string                 // Task to give Claude
+	Context    string                 // Additional context
+	Validators []Validator            // Functions to validate output
+	Setup      func(workDir string)   // Optional setup function
+	Teardown   func(workDir string)   // Optional teardown function
+	Expected   map[string]interface{} // Expected values for structured validation
+	Iterations int                    // Number of times to run (for consistency testing)
+	MaxRetries int                    // Retries on a RequeueError before marking the test failed
+	Serial     bool                   // If true, never run concurrently with another Serial case (for Setup/Teardown that mutate shared external state)
+	MinScore   float64                // Score a run must meet to count as Passed (0 = use TestRunner.DefaultMinScore, then 0.70)
+
+	// Extension and ExtensionType identify which discovered extension this
+	// case exercises, so a Watcher can map a changed file back to the cases
+	// it should rerun. Both default from Skill/"skills" when empty, so
+	// existing skill suites don't need to set them.
+	Extension     string
+	ExtensionType string
+}
+
+// extension returns tc's (kind, name) pair, defaulting ExtensionType to
+// "skills" and Extension to Skill when unset.
+func (tc *TestCase) extension() (kind, name string) {
+	kind = tc.ExtensionType
+	if kind == "" {
+		kind = "skills"
+	}
+	name = tc.Extension
+	if name == "" {
+		name = tc.Skill
+	}
+	return kind, name
 }
 
 // TestResult captures the outcome of a test run.
 type TestResult struct {
 	Name        string        `json:"name"`
 	Skill       string        `json:"skill"`
+	Prompt      string        `json:"prompt,omitempty"` // the TestCase.Prompt that produced Output, so a Validator (e.g. PluginValidator) can see it without capturing tc directly
 	Passed      bool          `json:"passed"`
-	Score       float64       `json:"score"`      // 0.0-1.0
-	Output      string        `json:"output"`     // Claude's response
+	Score       float64       `json:"score"`  // 0.0-1.0
+	Output      string        `json:"output"` // Claude's response
 	Duration    time.Duration `json:"duration"`
 	Validations []Validation  `json:"validations"`
 	Error       error         `json:"error,omitempty"`
 	Iteration   int           `json:"iteration"` // Which run this was
+	Attempts    int           `json:"attempts"`  // Attempts made, including the final one; >1 means it was requeued
 }
 
 // Validation is a single validation result.
@@ -76,11 +189,38 @@ type Validation struct {
 	Passed  bool    `json:"passed"`
 	Score   float64 `json:"score"`
 	Message string  `json:"message"`
+
+	// Weight scales this Validation's contribution to its TestResult's
+	// overall Score; 0 (the zero value, what every existing Validator
+	// leaves it at) is treated as 1 so unweighted validators keep counting
+	// equally. Set via WeightedValidator/Weighted, not by a plain Validator.
+	Weight float64 `json:"weight,omitempty"`
 }
 
 // Validator checks if output meets expectations.
 type Validator func(output string, result *TestResult) Validation
 
+// WeightedValidator pairs a Validator with a relative Weight for Run's
+// score aggregation, so a critical check (NoErrors) can outweigh a
+// surface one (ContainsText("spinner")) instead of every validator
+// counting equally toward TestResult.Score.
+type WeightedValidator struct {
+	V      Validator
+	Weight float64
+}
+
+// Weighted adapts a WeightedValidator into a plain Validator so it can sit
+// in TestCase.Validators alongside unweighted ones - the returned
+// Validation carries wv.Weight, which Run's score aggregation reads back
+// out.
+func Weighted(wv WeightedValidator) Validator {
+	return func(output string, result *TestResult) Validation {
+		v := wv.V(output, result)
+		v.Weight = wv.Weight
+		return v
+	}
+}
+
 // Suite is a collection of test cases for a skill.
 type Suite struct {
 	Name     string      // Suite name
@@ -88,26 +228,54 @@ type Suite struct {
 	Cases    []*TestCase // Test cases
 	SetupAll func()      // Run before all tests
 	Teardown func()      // Run after all tests
+	MinScore float64     // Default TestCase.MinScore for any case that leaves it at 0; applied by RunSuite via TestRunner.DefaultMinScore
+	CIFloor  float64     // Default TestRunner.CIFloor for this suite; see CaseStats.CILow
 }
 
 // SuiteResult aggregates results for a suite.
 type SuiteResult struct {
-	Name       string        `json:"name"`
-	Skill      string        `json:"skill"`
-	TotalTests int           `json:"total_tests"`
-	Passed     int           `json:"passed"`
-	Failed     int           `json:"failed"`
-	Score      float64       `json:"score"` // Average score
-	Results    []*TestResult `json:"results"`
-	Duration   time.Duration `json:"duration"`
+	Name       string         `json:"name"`
+	Skill      string         `json:"skill"`
+	TotalTests int            `json:"total_tests"`
+	Passed     int            `json:"passed"`
+	Failed     int            `json:"failed"`
+	Score      float64        `json:"score"`     // Average score
+	Stability  float64        `json:"stability"` // Mean of CaseStats[*].Stability across cases
+	Results    []*TestResult  `json:"results"`
+	CaseStats  []CaseStats    `json:"case_stats,omitempty"` // Per-case pass rate, Wilson score CI, and stability across Iterations
+	Duration   time.Duration  `json:"duration"`
+	Baseline   []BaselineFlag `json:"baseline,omitempty"` // Regressions/flaky cases/promotion candidates found by comparing against BaselineStore; see baseline.go
+}
+
+// CaseStats summarizes one TestCase's Iterations: how many passed, a
+// Wilson score confidence interval on that pass rate (tighter than a raw
+// percentage when Iterations is small), and Stability - 1 minus the
+// variance of per-iteration Score, so 1.0 means every iteration scored
+// identically and lower means the LLM's output was inconsistent across
+// runs even if most of them passed.
+type CaseStats struct {
+	Name       string  `json:"name"`
+	Iterations int     `json:"iterations"`
+	Passed     int     `json:"passed"`
+	PassRate   float64 `json:"pass_rate"`
+	CILow      float64 `json:"ci_low"`
+	CIHigh     float64 `json:"ci_high"`
+	Stability  float64 `json:"stability"`
+}
+
+// BelowCIFloor reports whether cs's Wilson lower bound falls below floor -
+// a case can pass on its raw PassRate yet still trip this when Iterations
+// is too small to be confident the rate wasn't luck.
+func (cs CaseStats) BelowCIFloor(floor float64) bool {
+	return cs.CILow < floor
 }
 
 // GradeScale defines the grading criteria.
 type GradeScale struct {
-	A  float64 // >= A is excellent
-	B  float64 // >= B is good
-	C  float64 // >= C is acceptable
-	D  float64 // >= D is poor
+	A float64 // >= A is excellent
+	B float64 // >= B is good
+	C float64 // >= C is acceptable
+	D float64 // >= D is poor
 	// Below D is failing
 }
 
@@ -144,6 +312,7 @@ func (r *TestRunner) Run(ctx context.Context, tc *TestCase) (*TestResult, error)
 	result := &TestResult{
 		Name:      tc.Name,
 		Skill:     tc.Skill,
+		Prompt:    tc.Prompt,
 		Iteration: 1,
 	}
 
@@ -153,7 +322,7 @@ func (r *TestRunner) Run(ctx context.Context, tc *TestCase) (*TestResult, error)
 		result.Error = fmt.Errorf("create workspace: %w", err)
 		return result, err
 	}
-	defer os.RemoveAll(workDir)
+	defer r.FS.RemoveAll(workDir)
 
 	// Run setup if provided
 	if tc.Setup != nil {
@@ -165,8 +334,11 @@ func (r *TestRunner) Run(ctx context.Context, tc *TestCase) (*TestResult, error)
 		}
 	}()
 
-	// Build Claude command
-	output, err := r.runClaude(ctx, workDir, tc.Skill, tc.Prompt, tc.Context)
+	// Build Claude command. runClaudeWithRetry transparently requeues
+	// transient failures (rate limits, 5xxs, timeouts); only the final
+	// successful output - or the last error, once retries are exhausted -
+	// reaches the caller, so validators never see a requeued attempt.
+	output, err := r.runClaudeWithRetry(ctx, workDir, tc, result)
 	if err != nil {
 		result.Error = err
 		result.Duration = time.Since(start)
@@ -178,18 +350,23 @@ func (r *TestRunner) Run(ctx context.Context, tc *TestCase) (*TestResult, error)
 
 	// Run validators
 	totalScore := 0.0
+	totalWeight := 0.0
 	for _, validator := range tc.Validators {
 		v := validator(output, result)
+		if v.Weight == 0 {
+			v.Weight = 1
+		}
 		result.Validations = append(result.Validations, v)
+		totalWeight += v.Weight
 		if v.Passed {
-			totalScore += v.Score
+			totalScore += v.Score * v.Weight
 		}
 	}
 
 	// Calculate overall score
-	if len(tc.Validators) > 0 {
-		result.Score = totalScore / float64(len(tc.Validators))
-		result.Passed = result.Score >= 0.7 // 70% threshold
+	if totalWeight > 0 {
+		result.Score = totalScore / totalWeight
+		result.Passed = result.Score >= r.minScore(tc)
 	} else {
 		result.Score = 1.0
 		result.Passed = true
@@ -201,7 +378,146 @@ func (r *TestRunner) Run(ctx context.Context, tc *TestCase) (*TestResult, error)
 	return result, nil
 }
 
-// RunSuite executes all tests in a suite.
+// minScore resolves the pass/fail bar for tc: tc.MinScore if it set one,
+// else r.DefaultMinScore (which RunSuite fills in from Suite.MinScore),
+// else the historical 70% threshold.
+func (r *TestRunner) minScore(tc *TestCase) float64 {
+	if tc.MinScore > 0 {
+		return tc.MinScore
+	}
+	if r.DefaultMinScore > 0 {
+		return r.DefaultMinScore
+	}
+	return 0.7
+}
+
+// ciFloor resolves the Wilson lower-bound CaseStats.BelowCIFloor checks
+// against: r.CIFloor if set, else the historical 0.40 default.
+func (r *TestRunner) ciFloor() float64 {
+	if r.CIFloor > 0 {
+		return r.CIFloor
+	}
+	return 0.40
+}
+
+// computeCaseStats groups slots by TestResult.Name (preserving first-seen
+// order) and reduces each group to a CaseStats - the per-case pass
+// rate/CI/stability RunSuite attaches to SuiteResult.
+func computeCaseStats(slots []*TestResult) []CaseStats {
+	type group struct {
+		passed int
+		scores []float64
+	}
+
+	groups := make(map[string]*group)
+	var order []string
+	for _, tr := range slots {
+		g, ok := groups[tr.Name]
+		if !ok {
+			g = &group{}
+			groups[tr.Name] = g
+			order = append(order, tr.Name)
+		}
+		g.scores = append(g.scores, tr.Score)
+		if tr.Passed {
+			g.passed++
+		}
+	}
+
+	stats := make([]CaseStats, 0, len(order))
+	for _, name := range order {
+		g := groups[name]
+		n := len(g.scores)
+		low, high := wilsonInterval(g.passed, n)
+		stats = append(stats, CaseStats{
+			Name:       name,
+			Iterations: n,
+			Passed:     g.passed,
+			PassRate:   float64(g.passed) / float64(n),
+			CILow:      low,
+			CIHigh:     high,
+			Stability:  scoreStability(g.scores),
+		})
+	}
+	return stats
+}
+
+// wilsonZ is the z-score for a 95% confidence interval.
+const wilsonZ = 1.96
+
+// wilsonInterval computes the Wilson score confidence interval for
+// successes out of n trials - a tighter, less overconfident bound than a
+// raw successes/n percentage when n is small, the way a skill test case's
+// Iterations usually are.
+func wilsonInterval(successes, n int) (low, high float64) {
+	if n == 0 {
+		return 0, 0
+	}
+
+	p := float64(successes) / float64(n)
+	z2 := wilsonZ * wilsonZ
+	nf := float64(n)
+
+	denom := 1 + z2/nf
+	center := (p + z2/(2*nf)) / denom
+	margin := (wilsonZ / denom) * math.Sqrt(p*(1-p)/nf+z2/(4*nf*nf))
+
+	low, high = center-margin, center+margin
+	if low < 0 {
+		low = 0
+	}
+	if high > 1 {
+		high = 1
+	}
+	return low, high
+}
+
+// scoreStability reduces a case's per-iteration Scores to 1 minus their
+// variance, so a case that scores identically every iteration reports
+// 1.0 and one whose score swings between runs reports lower.
+func scoreStability(scores []float64) float64 {
+	n := len(scores)
+	if n == 0 {
+		return 1.0
+	}
+
+	mean := 0.0
+	for _, s := range scores {
+		mean += s
+	}
+	mean /= float64(n)
+
+	variance := 0.0
+	for _, s := range scores {
+		d := s - mean
+		variance += d * d
+	}
+	variance /= float64(n)
+
+	stability := 1 - variance
+	if stability < 0 {
+		stability = 0
+	}
+	return stability
+}
+
+// suiteJob is one TestCase iteration queued by RunSuite, tagged with its
+// position in SuiteResult.Results so concurrent workers can write their
+// result into the right slot regardless of completion order.
+type suiteJob struct {
+	tc        *TestCase
+	iteration int
+	slot      int
+}
+
+// RunSuite executes all tests in a suite. When r.Parallelism > 1, case
+// iterations run concurrently across a worker pool - each in its own
+// createWorkspace-isolated directory - sharing a single token-bucket
+// limiter capped at r.RatePerMinute so a parallel suite can't blow past
+// Anthropic's rate limits. TestCase.Serial cases never run concurrently
+// with another Serial case, for Setup/Teardown that mutate shared external
+// state. SuiteResult.Results preserves the original (case, then iteration)
+// order regardless of which worker finishes first.
 func (r *TestRunner) RunSuite(ctx context.Context, suite *Suite) (*SuiteResult, error) {
 	start := time.Now()
 	result := &SuiteResult{
@@ -209,6 +525,13 @@ func (r *TestRunner) RunSuite(ctx context.Context, suite *Suite) (*SuiteResult,
 		Skill: suite.Skill,
 	}
 
+	if suite.MinScore > 0 && r.DefaultMinScore == 0 {
+		r.DefaultMinScore = suite.MinScore
+	}
+	if suite.CIFloor > 0 && r.CIFloor == 0 {
+		r.CIFloor = suite.CIFloor
+	}
+
 	if suite.SetupAll != nil {
 		suite.SetupAll()
 	}
@@ -218,44 +541,188 @@ func (r *TestRunner) RunSuite(ctx context.Context, suite *Suite) (*SuiteResult,
 		}
 	}()
 
-	totalScore := 0.0
+	var jobs []suiteJob
 	for _, tc := range suite.Cases {
 		iterations := tc.Iterations
 		if iterations == 0 {
 			iterations = 1
 		}
-
 		for i := 1; i <= iterations; i++ {
-			testCtx, cancel := context.WithTimeout(ctx, r.Timeout)
-			testResult, err := r.Run(testCtx, tc)
-			cancel()
+			jobs = append(jobs, suiteJob{tc: tc, iteration: i, slot: len(jobs)})
+		}
+	}
 
-			testResult.Iteration = i
-			if err != nil && r.Verbose {
-				fmt.Printf("Test %s (iteration %d) error: %v\n", tc.Name, i, err)
-			}
+	slots := make([]*TestResult, len(jobs))
+	limiter := newRateLimiter(r.RatePerMinute)
+	var serialMu sync.Mutex
+
+	runJob := func(j suiteJob) {
+		if j.tc.Serial {
+			serialMu.Lock()
+			defer serialMu.Unlock()
+		}
 
-			result.Results = append(result.Results, testResult)
-			result.TotalTests++
+		if err := limiter.Wait(ctx); err != nil {
+			slots[j.slot] = &TestResult{Name: j.tc.Name, Skill: j.tc.Skill, Iteration: j.iteration, Error: err}
+			return
+		}
+
+		r.emitProgress(ProgressEvent{TestName: j.tc.Name, Iteration: j.iteration, State: ProgressStarted})
+
+		testCtx, cancel := context.WithTimeout(ctx, r.Timeout)
+		testResult, err := r.Run(testCtx, j.tc)
+		cancel()
+
+		testResult.Iteration = j.iteration
+		if err != nil && r.Verbose {
+			fmt.Printf("Test %s (iteration %d) error: %v\n", j.tc.Name, j.iteration, err)
+		}
+
+		slots[j.slot] = testResult
+		r.emitProgress(ProgressEvent{TestName: j.tc.Name, Iteration: j.iteration, State: ProgressDone, Result: testResult})
+		if r.Reporter != nil {
+			r.Reporter.CaseResult(testResult)
+		}
+	}
 
-			if testResult.Passed {
-				result.Passed++
-			} else {
-				result.Failed++
+	workers := r.Parallelism
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobCh := make(chan suiteJob)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobCh {
+				runJob(j)
 			}
+		}()
+	}
+	for _, j := range jobs {
+		jobCh <- j
+	}
+	close(jobCh)
+	wg.Wait()
 
-			totalScore += testResult.Score
+	totalScore := 0.0
+	for _, tr := range slots {
+		result.Results = append(result.Results, tr)
+		result.TotalTests++
+
+		if tr.Passed {
+			result.Passed++
+		} else {
+			result.Failed++
 		}
+
+		totalScore += tr.Score
 	}
 
 	if result.TotalTests > 0 {
 		result.Score = totalScore / float64(result.TotalTests)
 	}
 
+	result.CaseStats = computeCaseStats(slots)
+	if len(result.CaseStats) > 0 {
+		stabilitySum := 0.0
+		for _, cs := range result.CaseStats {
+			stabilitySum += cs.Stability
+		}
+		result.Stability = stabilitySum / float64(len(result.CaseStats))
+	}
+
 	result.Duration = time.Since(start)
+
+	r.loadBaselineOnce()
+	result.Baseline = r.Baseline.Compare(result, r.RegressionDelta)
+	for _, f := range result.Baseline {
+		fmt.Printf("[baseline] %s: %s\n", f.Case, f.Message)
+	}
+	if r.BaselineUpdate {
+		r.Baseline.Update(result)
+		if err := r.Baseline.Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "saving baseline: %v\n", err)
+		}
+	}
+
+	if r.Reporter != nil {
+		r.Reporter.Summary(result)
+	}
 	return result, nil
 }
 
+// loadBaselineOnce populates r.Baseline from r.BaselinePath the first time
+// RunSuite needs it, the same lazy pattern discoverValidatorPluginsOnce uses
+// for ValidatorPlugins.
+func (r *TestRunner) loadBaselineOnce() {
+	if r.Baseline != nil {
+		return
+	}
+	path := r.BaselinePath
+	if path == "" {
+		path = "results/baseline.json"
+	}
+	store, err := LoadBaselineStore(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "loading baseline store: %v\n", err)
+		store = &BaselineStore{Path: path, Cases: map[string]*CaseBaseline{}}
+	}
+	r.Baseline = store
+}
+
+// Progress returns the channel RunSuite publishes ProgressEvents to, for
+// rendering a live dashboard (a natural fit for the bubbletea-tui skill)
+// while a large suite runs. Call it before RunSuite so early events aren't
+// dropped; the channel is buffered, and RunSuite never blocks on a slow or
+// absent reader.
+func (r *TestRunner) Progress() <-chan ProgressEvent {
+	if r.progressCh == nil {
+		r.progressCh = make(chan ProgressEvent, 64)
+	}
+	return r.progressCh
+}
+
+// emitProgress publishes ev if anyone has called Progress(); it never
+// blocks RunSuite, dropping the event if the channel is full.
+func (r *TestRunner) emitProgress(ev ProgressEvent) {
+	if r.progressCh == nil {
+		return
+	}
+	select {
+	case r.progressCh <- ev:
+	default:
+	}
+}
+
+// ProgressState is a ProgressEvent's point in a test case iteration's
+// lifecycle.
+type ProgressState int
+
+const (
+	ProgressStarted ProgressState = iota
+	ProgressDone
+)
+
+// String implements fmt.Stringer.
+func (s ProgressState) String() string {
+	if s == ProgressDone {
+		return "done"
+	}
+	return "started"
+}
+
+// ProgressEvent reports one TestCase iteration's lifecycle from RunSuite.
+// Result is set only when State is ProgressDone.
+type ProgressEvent struct {
+	TestName  string
+	Iteration int
+	State     ProgressState
+	Result    *TestResult
+}
+
 // runClaude executes the Claude CLI with a skill loaded.
 func (r *TestRunner) runClaude(ctx context.Context, workDir, skill, prompt, context string) (string, error) {
 	// In dry run mode, return a simulated response for structure validation
@@ -264,7 +731,7 @@ func (r *TestRunner) runClaude(ctx context.Context, workDir, skill, prompt, cont
 	}
 
 	args := []string{
-		"--print",  // Non-interactive mode
+		"--print",                        // Non-interactive mode
 		"--dangerously-skip-permissions", // Skip prompts for testing
 	}
 
@@ -272,10 +739,12 @@ func (r *TestRunner) runClaude(ctx context.Context, workDir, skill, prompt, cont
 	if skill != "" {
 		// Skills are loaded from the working directory's .claude/skills/
 		skillPath := filepath.Join(workDir, ".claude", "skills", skill)
-		if _, err := os.Stat(skillPath); os.IsNotExist(err) {
+		if exists, err := afero.DirExists(r.FS, skillPath); err != nil {
+			return "", fmt.Errorf("stat skill: %w", err)
+		} else if !exists {
 			// Copy skill to test workspace
 			srcSkill := filepath.Join(r.WorkDir, ".claude", "skills", skill)
-			if err := copyDir(srcSkill, skillPath); err != nil {
+			if err := copyDir(r.FS, srcSkill, skillPath); err != nil {
 				return "", fmt.Errorf("copy skill: %w", err)
 			}
 		}
@@ -297,55 +766,58 @@ func (r *TestRunner) runClaude(ctx context.Context, workDir, skill, prompt, cont
 
 	err := cmd.Run()
 	if err != nil {
-		return stdout.String(), fmt.Errorf("claude: %w: %s", err, stderr.String())
+		return stdout.String(), fmt.Errorf("claude: %w: %s", classifyClaudeError(ctx, err, stderr.String()), stderr.String())
 	}
 
 	return stdout.String(), nil
 }
 
-// createWorkspace creates an isolated test directory.
+// createWorkspace creates an isolated test directory on r.FS.
 func (r *TestRunner) createWorkspace(testName string) (string, error) {
-	if err := os.MkdirAll(r.OutputDir, 0755); err != nil {
+	if err := r.FS.MkdirAll(r.OutputDir, 0755); err != nil {
 		return "", err
 	}
 
 	safeName := regexp.MustCompile(`[^a-zA-Z0-9-]`).ReplaceAllString(testName, "-")
-	dir, err := os.MkdirTemp(r.OutputDir, fmt.Sprintf("test-%s-*", safeName))
+	dir, err := afero.TempDir(r.FS, r.OutputDir, fmt.Sprintf("test-%s-", safeName))
 	if err != nil {
 		return "", err
 	}
 
 	// Create .claude directory structure
-	if err := os.MkdirAll(filepath.Join(dir, ".claude", "skills"), 0755); err != nil {
+	if err := r.FS.MkdirAll(filepath.Join(dir, ".claude", "skills"), 0755); err != nil {
 		return "", err
 	}
 
 	// Initialize as git repo (skills often expect this)
-	if err := os.MkdirAll(filepath.Join(dir, ".git"), 0755); err != nil {
+	if err := r.FS.MkdirAll(filepath.Join(dir, ".git"), 0755); err != nil {
 		return "", err
 	}
 
 	return dir, nil
 }
 
-// saveOutput saves test output for inspection.
+// saveOutput saves test output for inspection on r.FS.
 func (r *TestRunner) saveOutput(testName, output string) error {
 	safeName := regexp.MustCompile(`[^a-zA-Z0-9-]`).ReplaceAllString(testName, "-")
 	outputPath := filepath.Join(r.OutputDir, fmt.Sprintf("%s-output.txt", safeName))
-	return os.WriteFile(outputPath, []byte(output), 0644)
+	return afero.WriteFile(r.FS, outputPath, []byte(output), 0644)
 }
 
-// SaveSuiteResults saves suite results as JSON.
+// SaveSuiteResults saves suite results as JSON on r.FS.
 func (r *TestRunner) SaveSuiteResults(result *SuiteResult, filename string) error {
 	data, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
 		return err
 	}
-	return os.WriteFile(filepath.Join(r.OutputDir, filename), data, 0644)
+	return afero.WriteFile(r.FS, filepath.Join(r.OutputDir, filename), data, 0644)
 }
 
-// copyDir recursively copies a directory.
-func copyDir(src, dst string) error {
+// copyDir recursively copies a directory from src on the real filesystem
+// (skill sources are static fixtures, always read from disk) onto dst on
+// destFS, so the destination can be a fast in-memory layer even while src
+// is real.
+func copyDir(destFS afero.Fs, src, dst string) error {
 	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -358,14 +830,14 @@ func copyDir(src, dst string) error {
 		dstPath := filepath.Join(dst, relPath)
 
 		if info.IsDir() {
-			return os.MkdirAll(dstPath, info.Mode())
+			return destFS.MkdirAll(dstPath, info.Mode())
 		}
 
 		data, err := os.ReadFile(path)
 		if err != nil {
 			return err
 		}
-		return os.WriteFile(dstPath, data, info.Mode())
+		return afero.WriteFile(destFS, dstPath, data, info.Mode())
 	})
 }
 
@@ -439,16 +911,25 @@ func FileCreated(filename string) Validator {
 	}
 }
 
-// RuleFollowed checks if a specific rule was followed.
-func RuleFollowed(ruleID, description string) Validator {
+// RuleFollowed checks whether a specific rule was followed, by asking judge
+// to grade the output against the rule's description.
+func RuleFollowed(judge *Judge, ruleID, description string) Validator {
 	return func(output string, _ *TestResult) Validation {
-		// This is a heuristic - we check if the output shows signs of following the rule
-		// More sophisticated validation would parse actual tool calls
+		prompt := fmt.Sprintf("Did the assistant follow rule %s (%s)? Score 0 (not at all) to 1 (fully).", ruleID, description)
+		jr, err := judge.Grade(context.Background(), prompt, output)
+		if err != nil {
+			return Validation{
+				Name:    fmt.Sprintf("rule: %s", ruleID),
+				Passed:  false,
+				Score:   0.0,
+				Message: fmt.Sprintf("judge error: %v", err),
+			}
+		}
 		return Validation{
 			Name:    fmt.Sprintf("rule: %s", ruleID),
-			Passed:  true, // Default to true, specific rules override
-			Score:   1.0,
-			Message: fmt.Sprintf("Rule %s: %s - check manually", ruleID, description),
+			Passed:  jr.Score >= 0.7,
+			Score:   jr.Score,
+			Message: jr.Reasoning,
 		}
 	}
 }
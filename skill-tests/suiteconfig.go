@@ -0,0 +1,250 @@
+package skilltests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SuiteConfig mirrors Suite/TestCase/Validator as a declarative schema, so a
+// team can publish a canonical validation suite for their skills library at
+// a stable URL instead of requiring contributors to clone the repo that
+// defines the compiled-in _test.go suites. LoadSuiteSpec fetches one of
+// these; BuildSuite turns it into a real Suite.
+type SuiteConfig struct {
+	Name  string           `yaml:"name" json:"name"`
+	Skill string           `yaml:"skill" json:"skill"`
+	Cases []TestCaseConfig `yaml:"cases" json:"cases"`
+}
+
+// TestCaseConfig mirrors TestCase. Skill defaults to the owning
+// SuiteConfig's Skill when empty, same as TestCase.Skill does nowhere
+// explicitly but every hand-written suite sets it per-case out of habit.
+type TestCaseConfig struct {
+	Name          string            `yaml:"name" json:"name"`
+	Skill         string            `yaml:"skill" json:"skill"`
+	Prompt        string            `yaml:"prompt" json:"prompt"`
+	Context       string            `yaml:"context" json:"context"`
+	Iterations    int               `yaml:"iterations" json:"iterations"`
+	MaxRetries    int               `yaml:"max_retries" json:"max_retries"`
+	Serial        bool              `yaml:"serial" json:"serial"`
+	Extension     string            `yaml:"extension" json:"extension"`
+	ExtensionType string            `yaml:"extension_type" json:"extension_type"`
+	Validators    []ValidatorConfig `yaml:"validators" json:"validators"`
+}
+
+// ValidatorConfig is a tagged-union spec for one Validator: Type selects
+// which of the fields below apply, mirroring a constructor's argument
+// list. Unused fields for a given Type are simply left zero.
+type ValidatorConfig struct {
+	Type string `yaml:"type" json:"type"`
+
+	Text     string `yaml:"text,omitempty" json:"text,omitempty"`         // contains
+	Pattern  string `yaml:"pattern,omitempty" json:"pattern,omitempty"`   // regex
+	Lang     string `yaml:"lang,omitempty" json:"lang,omitempty"`         // code
+	Filename string `yaml:"filename,omitempty" json:"filename,omitempty"` // file-created
+	MinLen   int    `yaml:"min_len,omitempty" json:"min_len,omitempty"`   // length
+	MaxLen   int    `yaml:"max_len,omitempty" json:"max_len,omitempty"`   // length
+
+	// LLMValidator variants, graded through the suite's shared Judge.
+	Claim       string       `yaml:"claim,omitempty" json:"claim,omitempty"`             // semantic-contains
+	Threshold   float64      `yaml:"threshold,omitempty" json:"threshold,omitempty"`     // semantic-contains
+	Criteria    []RubricItem `yaml:"criteria,omitempty" json:"criteria,omitempty"`       // rubric
+	Prompt      string       `yaml:"prompt,omitempty" json:"prompt,omitempty"`           // llm/judge-prompt
+	Model       string       `yaml:"model,omitempty" json:"model,omitempty"`             // llm/judge-prompt
+	RuleID      string       `yaml:"rule_id,omitempty" json:"rule_id,omitempty"`         // rule-followed
+	Description string       `yaml:"description,omitempty" json:"description,omitempty"` // rule-followed
+
+	// PluginName and PluginConfig select a validator plugin registered on
+	// the TestRunner (see plugin.go), so a suite can name an out-of-process
+	// check without a Go closure for it.
+	PluginName   string                 `yaml:"name,omitempty" json:"name,omitempty"`     // plugin
+	PluginConfig map[string]interface{} `yaml:"config,omitempty" json:"config,omitempty"` // plugin
+
+	// Golden and Scrub only apply within a .txtar fixture loaded through
+	// LoadSuiteFromTxtar: Golden names the fixture's "<case>/golden/<name>"
+	// section to compare against, Scrub lists regex patterns whose matches
+	// are replaced with "<SCRUBBED>" on both sides before comparing.
+	Golden string   `yaml:"golden,omitempty" json:"golden,omitempty"` // golden
+	Scrub  []string `yaml:"scrub,omitempty" json:"scrub,omitempty"`   // golden
+}
+
+// BuildSuite turns cfg into a real Suite, resolving each ValidatorConfig
+// into the Validator constructor it names. runner backs any LLM-graded
+// variant (semantic-contains, rubric, llm, rule-followed) through its Judge,
+// and any "plugin" variant through its ValidatorPlugins.
+func BuildSuite(cfg *SuiteConfig, runner *TestRunner) (*Suite, error) {
+	suite := &Suite{Name: cfg.Name, Skill: cfg.Skill}
+
+	for _, tcCfg := range cfg.Cases {
+		skill := tcCfg.Skill
+		if skill == "" {
+			skill = cfg.Skill
+		}
+
+		tc := &TestCase{
+			Name:          tcCfg.Name,
+			Skill:         skill,
+			Prompt:        tcCfg.Prompt,
+			Context:       tcCfg.Context,
+			Iterations:    tcCfg.Iterations,
+			MaxRetries:    tcCfg.MaxRetries,
+			Serial:        tcCfg.Serial,
+			Extension:     tcCfg.Extension,
+			ExtensionType: tcCfg.ExtensionType,
+		}
+
+		for _, vCfg := range tcCfg.Validators {
+			v, err := buildValidator(vCfg, runner)
+			if err != nil {
+				return nil, fmt.Errorf("suite %s case %s: %w", cfg.Name, tcCfg.Name, err)
+			}
+			tc.Validators = append(tc.Validators, v)
+		}
+
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	return suite, nil
+}
+
+// buildValidator resolves one ValidatorConfig into a Validator, by the same
+// name cmd/run-tests --suite documents.
+func buildValidator(cfg ValidatorConfig, runner *TestRunner) (Validator, error) {
+	judge := runner.Judge
+	switch cfg.Type {
+	case "contains":
+		return ContainsText(cfg.Text), nil
+	case "regex":
+		return MatchesRegex(cfg.Pattern), nil
+	case "code":
+		return ContainsCode(cfg.Lang), nil
+	case "file-created":
+		return FileCreated(cfg.Filename), nil
+	case "length":
+		return OutputLength(cfg.MinLen, cfg.MaxLen), nil
+	case "no-errors":
+		return NoErrors(), nil
+	case "semantic-contains":
+		return SemanticContains(judge, cfg.Claim, cfg.Threshold), nil
+	case "rubric":
+		return Rubric(judge, cfg.Criteria), nil
+	case "llm", "judge-prompt":
+		return JudgePrompt(judge, cfg.Prompt, cfg.Model), nil
+	case "rule-followed":
+		return RuleFollowed(judge, cfg.RuleID, cfg.Description), nil
+	case "plugin":
+		return PluginValidator(runner, cfg.PluginName, cfg.PluginConfig), nil
+	case "golden":
+		return nil, fmt.Errorf("validator type %q only resolves within a .txtar fixture; load the suite with LoadSuiteFromTxtar", cfg.Type)
+	default:
+		return nil, fmt.Errorf("unknown validator type %q", cfg.Type)
+	}
+}
+
+// LoadSuiteSpec fetches a SuiteConfig from loc, which may be an http(s) URL
+// or a local file path. URL fetches are cached under
+// ~/.claude/cache/suites/ so a team's published suite is only refetched
+// when the cache is cleared - useful for a skill marketplace where the
+// skill author and the test author are different parties, and contributors
+// running the suite shouldn't need to clone the repo that defines it. The
+// content is parsed as YAML, which also accepts plain JSON.
+func LoadSuiteSpec(ctx context.Context, loc string) (*SuiteConfig, error) {
+	data, err := fetchSuiteSpec(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg SuiteConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parsing suite spec %s: %w", loc, err)
+	}
+	return &cfg, nil
+}
+
+// LoadSuiteFromURL is LoadSuiteSpec followed by BuildSuite, the common case
+// of a --suite flag that wants a ready-to-run Suite in one call.
+func LoadSuiteFromURL(ctx context.Context, loc string, runner *TestRunner) (*Suite, error) {
+	cfg, err := LoadSuiteSpec(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+	return BuildSuite(cfg, runner)
+}
+
+// fetchSuiteSpec reads loc's raw bytes: straight off disk for a local path,
+// or from the suite cache (falling back to an HTTP fetch on a miss) for an
+// http(s) URL.
+func fetchSuiteSpec(ctx context.Context, loc string) ([]byte, error) {
+	if !strings.HasPrefix(loc, "http://") && !strings.HasPrefix(loc, "https://") {
+		data, err := os.ReadFile(loc)
+		if err != nil {
+			return nil, fmt.Errorf("reading suite spec %s: %w", loc, err)
+		}
+		return data, nil
+	}
+
+	cachePath, err := suiteCachePath(loc)
+	if err == nil {
+		if data, err := os.ReadFile(cachePath); err == nil {
+			return data, nil
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, loc, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request for %s: %w", loc, err)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching suite spec %s: %w", loc, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching suite spec %s: status %s", loc, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading suite spec %s: %w", loc, err)
+	}
+
+	if cachePath != "" {
+		if mkErr := os.MkdirAll(filepath.Dir(cachePath), 0755); mkErr == nil {
+			os.WriteFile(cachePath, data, 0644) // best-effort: a cache write failure shouldn't fail the fetch
+		}
+	}
+
+	return data, nil
+}
+
+// suiteCachePath derives a URL's cache file under ~/.claude/cache/suites/,
+// content-addressed by the URL itself (not its body, unlike
+// BenchmarkRunner's result cache) so the same URL always resolves to the
+// same path.
+func suiteCachePath(url string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(url))
+	ext := filepath.Ext(url)
+	if ext != ".yaml" && ext != ".yml" && ext != ".json" {
+		ext = ".yaml"
+	}
+
+	return filepath.Join(home, ".claude", "cache", "suites", hex.EncodeToString(sum[:16])+ext), nil
+}
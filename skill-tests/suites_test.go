@@ -0,0 +1,84 @@
+package skilltests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestAllSuites runs every suites/*.yaml declarative suite (see
+// suiteconfig.go) through RunSuite, so `go test` still covers them without
+// any Go file per suite - the suites/ directory is the whole surface a
+// contributor who only knows YAML needs to touch. cmd/claude-skill-test
+// runs the exact same suites outside of `go test`.
+func TestAllSuites(t *testing.T) {
+	if os.Getenv("SKILL_TEST") == "" {
+		t.Skip("Set SKILL_TEST=1 to run skill tests (requires Claude CLI)")
+	}
+
+	paths, err := filepath.Glob(filepath.Join("suites", "*.yaml"))
+	if err != nil {
+		t.Fatalf("globbing suites/*.yaml: %v", err)
+	}
+	ymlPaths, err := filepath.Glob(filepath.Join("suites", "*.yml"))
+	if err != nil {
+		t.Fatalf("globbing suites/*.yml: %v", err)
+	}
+	paths = append(paths, ymlPaths...)
+
+	if len(paths) == 0 {
+		t.Skip("no suites/*.yaml suites found")
+	}
+
+	runner := NewTestRunner()
+	runner.WorkDir = findProjectRoot()
+	runner.Verbose = testing.Verbose()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Minute)
+	defer cancel()
+
+	for _, path := range paths {
+		path := path
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			cfg, err := LoadSuiteSpec(ctx, path)
+			if err != nil {
+				t.Fatalf("loading %s: %v", path, err)
+			}
+
+			suite, err := BuildSuite(cfg, runner)
+			if err != nil {
+				t.Fatalf("building %s: %v", path, err)
+			}
+
+			result, err := runner.RunSuite(ctx, suite)
+			if err != nil {
+				t.Fatalf("running %s: %v", path, err)
+			}
+
+			if err := runner.SaveSuiteResults(result, suite.Name+"-results.json"); err != nil {
+				t.Logf("Warning: couldn't save results: %v", err)
+			}
+
+			t.Logf("Suite: %s", result.Name)
+			t.Logf("Tests: %d total, %d passed, %d failed", result.TotalTests, result.Passed, result.Failed)
+			t.Logf("Score: %.2f%% (Grade: %s)", result.Score*100, DefaultGradeScale().Grade(result.Score))
+
+			for _, r := range result.Results {
+				if !r.Passed {
+					t.Logf("FAILED: %s (iteration %d) - Score: %.2f%%", r.Name, r.Iteration, r.Score*100)
+					for _, v := range r.Validations {
+						if !v.Passed {
+							t.Logf("  - %s: %s", v.Name, v.Message)
+						}
+					}
+				}
+			}
+
+			if result.Score < 0.70 {
+				t.Errorf("Suite score %.2f%% is below 70%% threshold", result.Score*100)
+			}
+		})
+	}
+}
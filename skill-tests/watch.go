@@ -0,0 +1,214 @@
+package skilltests
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/MateoSegura/.claude/theme"
+)
+
+// watchedKinds are the .claude subdirectories Watcher monitors.
+var watchedKinds = []string{"skills", "commands", "rules", "agents", "hooks"}
+
+// extensionKey identifies one discovered extension by kind and name, e.g.
+// {"skills", "foo-bar-baz"}.
+type extensionKey struct {
+	kind, name string
+}
+
+// WatchEvent is one rerun Watcher triggered.
+type WatchEvent struct {
+	Case   *TestCase
+	Result *TestResult
+	Err    error
+}
+
+// Watcher monitors a project's .claude extension directories and reruns
+// only the TestCases whose Extension/ExtensionType match whatever changed,
+// debouncing bursts of saves (e.g. a `:w` that touches several files) into
+// a single rerun per coalescing window.
+type Watcher struct {
+	Runner   *TestRunner
+	Suites   []*Suite
+	Root     string        // project root containing .claude/
+	Debounce time.Duration // coalescing window; defaults to 500ms if zero
+
+	// OnResult is called once per rerun TestCase. When nil, Watcher prints a
+	// colorized pass/fail line to stdout instead.
+	OnResult func(WatchEvent)
+
+	theme *theme.Theme
+}
+
+// NewWatcher creates a Watcher over suites rooted at root, with a 500ms
+// debounce window and the default theme for its own stdout output.
+func NewWatcher(runner *TestRunner, suites []*Suite, root string) *Watcher {
+	return &Watcher{
+		Runner:   runner,
+		Suites:   suites,
+		Root:     root,
+		Debounce: 500 * time.Millisecond,
+		theme:    theme.Default(),
+	}
+}
+
+// Run watches for extension changes under w.Root/.claude until ctx is
+// canceled, rerunning affected TestCases as they happen.
+func (w *Watcher) Run(ctx context.Context) error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("watch: %w", err)
+	}
+	defer fsw.Close()
+
+	if err := w.addDirs(fsw); err != nil {
+		return fmt.Errorf("watch: %w", err)
+	}
+
+	debounce := w.Debounce
+	if debounce <= 0 {
+		debounce = 500 * time.Millisecond
+	}
+
+	pending := make(map[extensionKey]bool)
+	var timerC <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case ev, ok := <-fsw.Events:
+			if !ok {
+				return nil
+			}
+
+			if ev.Op&(fsnotify.Create|fsnotify.Rename) != 0 {
+				// The tree may have grown a new extension directory, or an
+				// existing one may have moved; re-scan so new paths get a
+				// watch registered.
+				w.addDirs(fsw)
+			}
+
+			kind, name, ok := extensionOf(w.Root, ev.Name)
+			if !ok {
+				continue
+			}
+			pending[extensionKey{kind, name}] = true
+			timerC = time.After(debounce)
+
+		case <-timerC:
+			changed := pending
+			pending = make(map[extensionKey]bool)
+			timerC = nil
+			w.rerun(ctx, changed)
+
+		case err, ok := <-fsw.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "watch: %v\n", err)
+		}
+	}
+}
+
+// addDirs (re-)registers a watch on every directory under each watched kind.
+// fsnotify watches are non-recursive and a missing kind directory is fine,
+// so errors from the walk itself are swallowed; only Add failures on dirs
+// that do exist are reported.
+func (w *Watcher) addDirs(fsw *fsnotify.Watcher) error {
+	var addErr error
+	for _, kind := range watchedKinds {
+		kindDir := filepath.Join(w.Root, ".claude", kind)
+		filepath.Walk(kindDir, func(path string, info os.FileInfo, err error) error {
+			if err != nil || !info.IsDir() {
+				return nil
+			}
+			// re-adding an existing watch is a harmless no-op
+			if err := fsw.Add(path); err != nil && addErr == nil {
+				addErr = err
+			}
+			return nil
+		})
+	}
+	return addErr
+}
+
+// extensionOf maps a changed file path to the (kind, name) of the extension
+// it belongs to, e.g. ".claude/skills/foo-bar/SKILL.md" -> ("skills",
+// "foo-bar"). ok is false for paths outside any watched kind directory.
+func extensionOf(root, path string) (kind, name string, ok bool) {
+	rel, err := filepath.Rel(filepath.Join(root, ".claude"), path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", "", false
+	}
+
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if len(parts) < 2 {
+		return "", "", false
+	}
+
+	known := false
+	for _, k := range watchedKinds {
+		if k == parts[0] {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return "", "", false
+	}
+
+	return parts[0], strings.TrimSuffix(parts[1], filepath.Ext(parts[1])), true
+}
+
+// rerun runs every TestCase whose Extension/ExtensionType is in changed,
+// across all of w.Suites.
+func (w *Watcher) rerun(ctx context.Context, changed map[extensionKey]bool) {
+	for _, suite := range w.Suites {
+		for _, tc := range suite.Cases {
+			kind, name := tc.extension()
+			if !changed[extensionKey{kind, name}] {
+				continue
+			}
+
+			runCtx, cancel := context.WithTimeout(ctx, w.Runner.Timeout)
+			result, err := w.Runner.Run(runCtx, tc)
+			cancel()
+
+			ev := WatchEvent{Case: tc, Result: result, Err: err}
+			if w.OnResult != nil {
+				w.OnResult(ev)
+				continue
+			}
+			w.printResult(ev)
+		}
+	}
+}
+
+// printResult is the default OnResult: a colorized one-line pass/fail
+// delta, consistent with BenchmarkResult.FprintReport's style of resolving
+// colors through a *theme.Theme rather than hardcoding them.
+func (w *Watcher) printResult(ev WatchEvent) {
+	goodStyle := lipgloss.NewStyle().Foreground(w.theme.Success)
+	badStyle := lipgloss.NewStyle().Foreground(w.theme.Error)
+
+	if ev.Err != nil {
+		fmt.Printf("%s %s: %v\n", badStyle.Render("✗"), ev.Case.Name, ev.Err)
+		return
+	}
+
+	style := badStyle
+	mark := "✗"
+	if ev.Result.Passed {
+		style, mark = goodStyle, "✓"
+	}
+	fmt.Printf("%s %s: %.0f%%\n", style.Render(mark), ev.Case.Name, ev.Result.Score*100)
+}
@@ -0,0 +1,90 @@
+// Package components - Cancellable Async Command Helpers
+//
+// USAGE: Copy alongside component.go. WithTimeout bounds a tea.Cmd that
+// might hang (an HTTP fetch, a slow exec.Command); Cancellable lets a later
+// keypress (typically esc) abort one that's still in flight.
+package components
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TimeoutMsg is sent when a WithTimeout-wrapped command's deadline elapses
+// before cmd itself produces a message.
+type TimeoutMsg struct {
+	ID string
+}
+
+// WithTimeout runs cmd with a context.WithTimeout deadline of d, tagged
+// with id so the Update case handling TimeoutMsg knows which command
+// expired. cmd's own tea.Msg still wins if it arrives before the deadline;
+// otherwise TimeoutMsg{ID: id} is sent and cmd's result, if it arrives
+// later, is dropped.
+func WithTimeout(id string, d time.Duration, cmd tea.Cmd) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), d)
+		defer cancel()
+
+		done := make(chan tea.Msg, 1)
+		go func() {
+			done <- cmd()
+		}()
+
+		select {
+		case msg := <-done:
+			return msg
+		case <-ctx.Done():
+			return TimeoutMsg{ID: id}
+		}
+	}
+}
+
+// cancelFuncs holds the in-flight context.CancelFunc registered by
+// Cancellable, keyed by the id passed to it. A package-level map (guarded
+// by cancelMu) is the simplest way to reach a cancel func from Cancel
+// without threading a context through every ComponentModel that wants
+// this behavior.
+var (
+	cancelMu    sync.Mutex
+	cancelFuncs = make(map[string]context.CancelFunc)
+)
+
+// Cancellable runs cmd with a cancellable context.Context, storing its
+// CancelFunc under id so a later call to Cancel(id) - typically from an
+// esc key binding - can abort it. The stored CancelFunc is removed once
+// cmd returns, whether it finished, was cancelled, or never checked
+// ctx.Done() at all.
+func Cancellable(id string, cmd func(ctx context.Context) tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		cancelMu.Lock()
+		cancelFuncs[id] = cancel
+		cancelMu.Unlock()
+
+		defer func() {
+			cancelMu.Lock()
+			delete(cancelFuncs, id)
+			cancelMu.Unlock()
+			cancel()
+		}()
+
+		return cmd(ctx)
+	}
+}
+
+// Cancel cancels the in-flight Cancellable command registered under id, if
+// any. It's a no-op if id isn't registered (already finished, or never
+// started).
+func Cancel(id string) {
+	cancelMu.Lock()
+	cancel, ok := cancelFuncs[id]
+	cancelMu.Unlock()
+	if ok {
+		cancel()
+	}
+}
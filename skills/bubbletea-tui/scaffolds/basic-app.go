@@ -7,29 +7,88 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/theme"
 )
 
-// === STYLES ===
+// === KEY BINDINGS ===
 
-var (
-	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#FFD700"))
+// AppAction is a command a key can be bound to. Add new actions here, with
+// a default binding in DefaultAppKeyMap, without changing AppKeyMap's shape.
+type AppAction int
 
-	selectedStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#000")).
-			Background(lipgloss.Color("#FFD700")).
-			Padding(0, 1)
+const (
+	ActionUp AppAction = iota
+	ActionDown
+	ActionToggle
+	ActionQuit
+)
 
-	normalStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FAFAFA"))
+var appActionNames = map[string]AppAction{
+	"up":     ActionUp,
+	"down":   ActionDown,
+	"toggle": ActionToggle,
+	"quit":   ActionQuit,
+}
 
-	mutedStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#6B6B6B"))
-)
+var appActionLabels = map[AppAction]string{
+	ActionUp:     "move",
+	ActionDown:   "move",
+	ActionToggle: "select",
+	ActionQuit:   "quit",
+}
+
+// AppKeyMap maps each AppAction to the key.Binding that triggers it.
+// Actions with no entry are unbound.
+type AppKeyMap map[AppAction]key.Binding
+
+// DefaultAppKeyMap is the binding set initialModel starts with: j/k or
+// up/down to move, enter or space to select, q or ctrl+c to quit.
+func DefaultAppKeyMap() AppKeyMap {
+	return AppKeyMap{
+		ActionUp:     key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("j/k", "move")),
+		ActionDown:   key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("j/k", "move")),
+		ActionToggle: key.NewBinding(key.WithKeys("enter", " "), key.WithHelp("space", "select")),
+		ActionQuit:   key.NewBinding(key.WithKeys("q", "ctrl+c"), key.WithHelp("q", "quit")),
+	}
+}
+
+// ParseAppBindings parses a comma-separated "action:key" spec, e.g.
+// "quit:ctrl+q,toggle:x", into an AppKeyMap suitable for model.SetKeyMap.
+// Recognized actions are up, down, toggle, and quit.
+func ParseAppBindings(spec string) (AppKeyMap, error) {
+	km := AppKeyMap{}
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return km, nil
+	}
+
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid binding %q: want action:key", pair)
+		}
+		actionName := strings.TrimSpace(parts[0])
+		keyName := strings.TrimSpace(parts[1])
+
+		action, ok := appActionNames[actionName]
+		if !ok {
+			return nil, fmt.Errorf("unknown action %q", actionName)
+		}
+		km[action] = key.NewBinding(key.WithKeys(keyName), key.WithHelp(keyName, appActionLabels[action]))
+	}
+
+	return km, nil
+}
 
 // === MODEL ===
 
@@ -39,12 +98,25 @@ type model struct {
 	selected map[int]struct{}
 	width    int
 	height   int
+	theme    *theme.Theme
+	keys     AppKeyMap
 }
 
 func initialModel() model {
 	return model{
 		choices:  []string{"Option 1", "Option 2", "Option 3"},
 		selected: make(map[int]struct{}),
+		theme:    theme.Default(),
+		keys:     DefaultAppKeyMap(),
+	}
+}
+
+// SetKeyMap merges km onto the current bindings (DefaultAppKeyMap until
+// this is called), so passing a partial map from ParseAppBindings only
+// rebinds the actions it mentions.
+func (m *model) SetKeyMap(km AppKeyMap) {
+	for action, binding := range km {
+		m.keys[action] = binding
 	}
 }
 
@@ -73,21 +145,21 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c", "q":
+		switch {
+		case key.Matches(msg, m.keys[ActionQuit]):
 			return m, tea.Quit
 
-		case "up", "k":
+		case key.Matches(msg, m.keys[ActionUp]):
 			if m.cursor > 0 {
 				m.cursor--
 			}
 
-		case "down", "j":
+		case key.Matches(msg, m.keys[ActionDown]):
 			if m.cursor < len(m.choices)-1 {
 				m.cursor++
 			}
 
-		case "enter", " ":
+		case key.Matches(msg, m.keys[ActionToggle]):
 			if _, ok := m.selected[m.cursor]; ok {
 				delete(m.selected, m.cursor)
 			} else {
@@ -102,6 +174,13 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // === VIEW ===
 
 func (m model) View() string {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(m.theme.Primary)
+	selectedStyle := lipgloss.NewStyle().
+		Foreground(m.theme.Background).
+		Background(m.theme.Primary).
+		Padding(0, 1)
+	mutedStyle := lipgloss.NewStyle().Foreground(m.theme.Muted)
+
 	s := titleStyle.Render("Select Options") + "\n\n"
 
 	for i, choice := range m.choices {
@@ -120,15 +199,32 @@ func (m model) View() string {
 		if m.cursor == i {
 			s += selectedStyle.Render(line) + "\n"
 		} else {
-			s += normalStyle.Render(line) + "\n"
+			s += line + "\n"
 		}
 	}
 
-	s += "\n" + mutedStyle.Render("j/k: move  space: select  q: quit")
+	s += "\n" + mutedStyle.Render(m.renderFooterHelp())
 
 	return s
 }
 
+// renderFooterHelp builds the bottom help line from the current key
+// bindings, so rebinding a key (via SetKeyMap/ParseAppBindings) updates the
+// displayed shortcut instead of leaving a stale hint.
+func (m model) renderFooterHelp() string {
+	order := []AppAction{ActionUp, ActionToggle, ActionQuit}
+	var parts []string
+	for _, action := range order {
+		b, ok := m.keys[action]
+		if !ok || len(b.Keys()) == 0 {
+			continue
+		}
+		help := b.Help()
+		parts = append(parts, fmt.Sprintf("%s: %s", help.Key, help.Desc))
+	}
+	return strings.Join(parts, "  ")
+}
+
 // === MAIN ===
 
 func main() {
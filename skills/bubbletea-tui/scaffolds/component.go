@@ -12,15 +12,22 @@ import (
 
 // === STYLES ===
 
+// borderColor and borderFocusedColor are AdaptiveColor rather than a plain
+// lipgloss.Color so this file still renders with reasonable contrast when
+// copied into a project whose terminal has a light background - lipgloss
+// resolves the Light/Dark pair automatically, no renderer plumbing needed.
 var (
+	borderColor        = lipgloss.AdaptiveColor{Light: "#6B6B6B", Dark: "#6B6B6B"}
+	borderFocusedColor = lipgloss.AdaptiveColor{Light: "#B8860B", Dark: "#FFD700"}
+
 	componentStyle = lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#6B6B6B")).
+			BorderForeground(borderColor).
 			Padding(1, 2)
 
 	componentFocusedStyle = lipgloss.NewStyle().
 				Border(lipgloss.RoundedBorder()).
-				BorderForeground(lipgloss.Color("#FFD700")).
+				BorderForeground(borderFocusedColor).
 				Padding(1, 2)
 )
 
@@ -61,11 +68,11 @@ type ComponentSelectedMsg struct {
 // ComponentModel is a reusable component.
 // Rename this to match your component's purpose (e.g., SidebarModel, ListModel).
 type ComponentModel struct {
-	items    []string
-	cursor   int
-	focused  bool
-	width    int
-	height   int
+	items   []string
+	cursor  int
+	focused bool
+	width   int
+	height  int
 }
 
 // NewComponentModel creates a new component.
@@ -175,7 +182,7 @@ func (m ComponentModel) View() string {
 		if i == m.cursor && m.focused {
 			line = lipgloss.NewStyle().
 				Bold(true).
-				Foreground(lipgloss.Color("#FFD700")).
+				Foreground(borderFocusedColor).
 				Render(line)
 		}
 
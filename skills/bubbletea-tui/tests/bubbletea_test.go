@@ -76,19 +76,22 @@ func TestBubbleTeaTUI(t *testing.T) {
 					skilltest.MatchesRegex(`lipgloss\.NewStyle\(\)`),
 					skilltest.MatchesRegex(`Border|Padding|Foreground|Background`),
 					skilltest.NoErrors(),
+					skilltest.HasAnyBorder(),
 				},
 				Iterations: 2,
 			},
 			{
 				Name:   "command-pattern",
 				Skill:  "bubbletea-tui",
-				Prompt: "Create a Bubble Tea model that fetches data asynchronously using tea.Cmd.",
+				Prompt: "Create a Bubble Tea model that fetches data asynchronously using tea.Cmd, with a timeout so a hung request can't block the UI forever and an esc binding that cancels it.",
 				Validators: []skilltest.Validator{
 					skilltest.ContainsCode("go"),
 					skilltest.ContainsText("tea.Cmd"),
+					skilltest.ContainsText("context.Context"),
 					skilltest.MatchesRegex(`func\s+\w+\(\)\s+tea\.Cmd`),
 					skilltest.NoErrors(),
 					skilltest.CustomValidator("returns-cmd", checkReturnsCmd),
+					skilltest.CustomValidator("cancellable-cmd", checkCancellableCmd),
 				},
 				Iterations: 2,
 			},
@@ -102,6 +105,7 @@ func TestBubbleTeaTUI(t *testing.T) {
 					skilltest.ContainsText("list.New"),
 					skilltest.ContainsText("list.Item"),
 					skilltest.NoErrors(),
+					skilltest.HasStyledForeground(),
 				},
 				Iterations: 2,
 			},
@@ -162,3 +166,27 @@ func checkReturnsCmd(output string) (bool, string) {
 	}
 	return false, "No clear tea.Cmd return pattern"
 }
+
+// checkCancellableCmd validates that the generated async command derives a
+// cancellable context.Context, defers its cancel func, and actually
+// selects on ctx.Done() instead of firing the request unbounded.
+func checkCancellableCmd(output string) (bool, string) {
+	hasTimeout := strings.Contains(output, "context.WithTimeout") || strings.Contains(output, "context.WithCancel")
+	hasDone := strings.Contains(output, "ctx.Done()")
+	hasDeferCancel := strings.Contains(output, "defer cancel()")
+
+	if hasTimeout && hasDone && hasDeferCancel {
+		return true, "cancellable context pattern found"
+	}
+	missing := []string{}
+	if !hasTimeout {
+		missing = append(missing, "context.WithTimeout/WithCancel")
+	}
+	if !hasDone {
+		missing = append(missing, "ctx.Done()")
+	}
+	if !hasDeferCancel {
+		missing = append(missing, "defer cancel()")
+	}
+	return false, "missing: " + strings.Join(missing, ", ")
+}
@@ -0,0 +1,357 @@
+// Package screens - Command Palette Scaffold
+//
+// USAGE: Copy alongside screen.go. Call RegisterCommand while setting up a
+// screen, then press ':' to open the palette and invoke commands by
+// (fuzzy-matched) name, optionally followed by "key=value" arguments.
+package screens
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/skills/framework-bubbletea/scaffolds/theme"
+)
+
+// === COMMAND TYPES ===
+
+// ArgType declares the expected type of a command argument, used to coerce
+// and validate palette input before dispatch.
+type ArgType int
+
+const (
+	ArgString ArgType = iota
+	ArgInt
+	ArgBool
+)
+
+// ArgSpec describes one argument a command accepts.
+type ArgSpec struct {
+	Key      string
+	Type     ArgType
+	Required bool
+}
+
+// CommandArgs holds coerced argument values keyed by ArgSpec.Key.
+type CommandArgs map[string]any
+
+// CommandSpec is a named, invocable action registered on a ScreenModel.
+type CommandSpec struct {
+	Name    string
+	Help    string
+	Args    []ArgSpec
+	Handler func(CommandArgs) tea.Cmd
+}
+
+// CommandMsg is emitted whenever a command is dispatched, whether from the
+// palette or via RunCommand, so a screen's Update can observe invocations
+// alongside (or instead of) the command's own Handler.
+type CommandMsg struct {
+	Name string
+	Args CommandArgs
+}
+
+// RegisterCommand adds a command to the screen's palette. Re-registering a
+// name replaces the previous spec.
+func (m *ScreenModel) RegisterCommand(name, help string, args []ArgSpec, handler func(CommandArgs) tea.Cmd) {
+	if m.commands == nil {
+		m.commands = make(map[string]CommandSpec)
+	}
+	m.commands[name] = CommandSpec{Name: name, Help: help, Args: args, Handler: handler}
+}
+
+// RunCommand parses "name key=value ..." and dispatches it exactly as the
+// palette would. This gives tests (and other screens) a way to drive
+// commands programmatically through the same entry point as the UI.
+func (m ScreenModel) RunCommand(input string) tea.Cmd {
+	name, args, err := parseCommandInput(input, m.commands)
+	if err != nil {
+		return func() tea.Msg { return ScreenErrorMsg{Err: err} }
+	}
+	return dispatchCommand(m.commands, name, args)
+}
+
+func dispatchCommand(commands map[string]CommandSpec, name string, args CommandArgs) tea.Cmd {
+	spec, ok := commands[name]
+	if !ok {
+		return nil
+	}
+
+	cmds := []tea.Cmd{func() tea.Msg { return CommandMsg{Name: name, Args: args} }}
+	if spec.Handler != nil {
+		cmds = append(cmds, spec.Handler(args))
+	}
+	return tea.Batch(cmds...)
+}
+
+func parseCommandInput(input string, commands map[string]CommandSpec) (string, CommandArgs, error) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return "", nil, fmt.Errorf("no command given")
+	}
+
+	name := fields[0]
+	spec, ok := commands[name]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown command: %s", name)
+	}
+
+	raw := make(map[string]string)
+	for _, tok := range fields[1:] {
+		key, value, found := strings.Cut(tok, "=")
+		if !found {
+			return "", nil, fmt.Errorf("malformed argument %q (want key=value)", tok)
+		}
+		raw[key] = value
+	}
+
+	args := make(CommandArgs)
+	for _, a := range spec.Args {
+		value, present := raw[a.Key]
+		if !present {
+			if a.Required {
+				return "", nil, fmt.Errorf("missing required argument %q", a.Key)
+			}
+			continue
+		}
+
+		coerced, err := coerceArg(value, a.Type)
+		if err != nil {
+			return "", nil, fmt.Errorf("argument %q: %w", a.Key, err)
+		}
+		args[a.Key] = coerced
+	}
+
+	return name, args, nil
+}
+
+func coerceArg(raw string, t ArgType) (any, error) {
+	switch t {
+	case ArgInt:
+		return strconv.Atoi(raw)
+	case ArgBool:
+		return strconv.ParseBool(raw)
+	default:
+		return raw, nil
+	}
+}
+
+// === PALETTE STYLES ===
+//
+// Each style reads theme.Current() on every call rather than being built
+// once as a package var, so a runtime theme.SetCurrent takes effect on the
+// next render.
+
+func paletteStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Current().Primary).
+		Padding(0, 1)
+}
+
+func paletteMatchStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Foreground(theme.Current().Muted)
+}
+
+func paletteMatchSelectedStyle() lipgloss.Style {
+	t := theme.Current()
+	return lipgloss.NewStyle().
+		Foreground(t.Surface).
+		Background(t.Primary)
+}
+
+func paletteHelpStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Foreground(theme.Current().Muted)
+}
+
+// paletteDismissedMsg is sent when the palette closes. line is empty when
+// the palette was cancelled, and "name [key=value ...]" when a command was
+// picked.
+type paletteDismissedMsg struct {
+	line string
+}
+
+func dismissPalette(line string) tea.Cmd {
+	return func() tea.Msg { return paletteDismissedMsg{line: line} }
+}
+
+// commandPaletteModel is the ':'-activated fuzzy command finder.
+type commandPaletteModel struct {
+	input    textinput.Model
+	commands map[string]CommandSpec
+	matches  []CommandSpec
+	cursor   int
+}
+
+func newCommandPalette(commands map[string]CommandSpec) commandPaletteModel {
+	ti := textinput.New()
+	ti.Prompt = ":"
+	ti.Focus()
+
+	p := commandPaletteModel{input: ti, commands: commands}
+	p.refilter()
+	return p
+}
+
+func (p *commandPaletteModel) refilter() {
+	type scored struct {
+		spec  CommandSpec
+		score int
+	}
+
+	// Only the first word names the command; the rest is key=value args.
+	query, _, _ := strings.Cut(strings.TrimSpace(p.input.Value()), " ")
+	var candidates []scored
+	for _, spec := range p.commands {
+		if query == "" {
+			candidates = append(candidates, scored{spec, 0})
+			continue
+		}
+		if score, ok := fuzzyScore(query, spec.Name); ok {
+			candidates = append(candidates, scored{spec, score})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].spec.Name < candidates[j].spec.Name
+	})
+
+	matches := make([]CommandSpec, len(candidates))
+	for i, c := range candidates {
+		matches[i] = c.spec
+	}
+	p.matches = matches
+
+	if p.cursor >= len(p.matches) {
+		p.cursor = len(p.matches) - 1
+	}
+	if p.cursor < 0 {
+		p.cursor = 0
+	}
+}
+
+// Update handles a key event for the palette. A non-nil command means the
+// palette should close; paletteDismissedMsg.line carries what was chosen.
+func (p commandPaletteModel) Update(msg tea.KeyMsg) (commandPaletteModel, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		return p, dismissPalette("")
+
+	case tea.KeyUp:
+		if p.cursor > 0 {
+			p.cursor--
+		}
+		return p, nil
+
+	case tea.KeyDown:
+		if p.cursor < len(p.matches)-1 {
+			p.cursor++
+		}
+		return p, nil
+
+	case tea.KeyEnter:
+		if p.cursor >= len(p.matches) {
+			return p, dismissPalette("")
+		}
+		line := p.matches[p.cursor].Name
+		if _, rest, ok := strings.Cut(strings.TrimSpace(p.input.Value()), " "); ok {
+			line += " " + rest
+		}
+		return p, dismissPalette(line)
+	}
+
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	p.refilter()
+	return p, cmd
+}
+
+// View renders the palette's own box (without centering/dimming - the
+// caller composites it over the dimmed background).
+func (p commandPaletteModel) View() string {
+	rows := make([]string, 0, len(p.matches))
+	for i, spec := range p.matches {
+		if i == p.cursor {
+			rows = append(rows, paletteMatchSelectedStyle().Render(spec.Name)+"  "+paletteHelpStyle().Render(spec.Help))
+		} else {
+			rows = append(rows, paletteMatchStyle().Render(spec.Name)+"  "+paletteHelpStyle().Render(spec.Help))
+		}
+	}
+
+	body := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	return paletteStyle().Render(lipgloss.JoinVertical(lipgloss.Left, p.input.View(), "", body))
+}
+
+// === FUZZY MATCHING ===
+
+// fuzzyScore reports an fzf-style relevance score for query as a
+// case-insensitive subsequence of target: bonus for matching at the start
+// or right after a word/camelCase boundary, bonus for consecutive matches,
+// and a penalty for each unmatched gap between matches.
+func fuzzyScore(query, target string) (int, bool) {
+	q := strings.ToLower(query)
+	t := strings.ToLower(target)
+
+	qi := 0
+	score := 0
+	lastMatch := -1
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if t[ti] != q[qi] {
+			continue
+		}
+
+		bonus := 1
+		switch {
+		case ti == 0:
+			bonus += 8
+		case isWordBoundary(target, ti):
+			bonus += 6
+		}
+		if lastMatch == ti-1 {
+			bonus += 4
+		} else if lastMatch >= 0 {
+			score -= ti - lastMatch - 1
+		}
+
+		score += bonus
+		lastMatch = ti
+		qi++
+	}
+
+	if qi < len(q) {
+		return 0, false
+	}
+	return score, true
+}
+
+// isWordBoundary reports whether the byte at index i in s starts a new
+// "word": it follows a non-alphanumeric rune, or it's an uppercase letter
+// right after a lowercase one (camelCase).
+func isWordBoundary(s string, i int) bool {
+	if i <= 0 {
+		return true
+	}
+
+	prev, cur := s[i-1], s[i]
+	if !isAlnum(prev) {
+		return true
+	}
+	return isUpper(cur) && isLower(prev)
+}
+
+func isAlnum(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+func isUpper(b byte) bool { return b >= 'A' && b <= 'Z' }
+func isLower(b byte) bool { return b >= 'a' && b <= 'z' }
@@ -0,0 +1,119 @@
+// Package screens - Async Data Source Scaffold
+//
+// USAGE: Copy alongside screen.go. Call SetDataSource to declare "load X,
+// show spinner, handle error" for a screen; it wires up cancellation (on
+// ScreenBackMsg) and refresh (the 'r' key) for you.
+//
+// NOTE: Go methods can't introduce their own type parameters, so the
+// generic entry points here (SetDataSource, HandleDataLoaded) are package
+// functions taking *ScreenModel rather than methods on it.
+package screens
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// === RETRY POLICY ===
+
+// RetryPolicy is an exponential backoff policy for DataSource loads. The
+// zero value disables retries (a single attempt, no delay).
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	d := p.BaseDelay * time.Duration(int64(1)<<uint(attempt))
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return d
+}
+
+// === DATA SOURCE ===
+
+// DataSource declares how a screen loads a value of type T: what to fetch
+// and how to retry transient failures.
+type DataSource[T any] struct {
+	// Load fetches the data. It must respect ctx cancellation.
+	Load func(ctx context.Context) (T, error)
+
+	// Retry controls backoff on failure.
+	Retry RetryPolicy
+}
+
+// DataLoadedMsg carries the result of a DataSource load, success or
+// failure. It's the typed counterpart to ScreenDataLoadedMsg/ScreenErrorMsg.
+type DataLoadedMsg[T any] struct {
+	Data T
+	Err  error
+}
+
+// SetDataSource starts loading data from ds, cancelling any load already in
+// flight on m. The result arrives as a DataLoadedMsg[T]; pass it to
+// HandleDataLoaded from the embedding screen's own Update. Subsequent 'r'
+// key presses reload from the same ds until SetDataSource is called again.
+func SetDataSource[T any](m *ScreenModel, ds DataSource[T]) tea.Cmd {
+	m.CancelLoad()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.loadCancel = cancel
+	m.reload = func() tea.Cmd { return SetDataSource(m, ds) }
+	m.SetLoading(true)
+
+	return loadDataCmd(ctx, ds)
+}
+
+// HandleDataLoaded applies a DataLoadedMsg[T] to m's loading/error state and
+// returns the loaded value along with whether it arrived without error.
+func HandleDataLoaded[T any](m *ScreenModel, msg DataLoadedMsg[T]) (T, bool) {
+	m.loading = false
+	if msg.Err != nil {
+		m.err = msg.Err
+		var zero T
+		return zero, false
+	}
+
+	m.err = nil
+	return msg.Data, true
+}
+
+func loadDataCmd[T any](ctx context.Context, ds DataSource[T]) tea.Cmd {
+	return func() tea.Msg {
+		attempts := ds.Retry.MaxAttempts
+		if attempts < 1 {
+			attempts = 1
+		}
+
+		var lastErr error
+		for attempt := 0; attempt < attempts; attempt++ {
+			if attempt > 0 {
+				select {
+				case <-ctx.Done():
+					return DataLoadedMsg[T]{Err: ctx.Err()}
+				case <-time.After(ds.Retry.delay(attempt - 1)):
+				}
+			}
+
+			data, err := ds.Load(ctx)
+			if err == nil {
+				return DataLoadedMsg[T]{Data: data}
+			}
+			lastErr = err
+
+			if ctx.Err() != nil {
+				return DataLoadedMsg[T]{Err: ctx.Err()}
+			}
+		}
+
+		return DataLoadedMsg[T]{Err: lastErr}
+	}
+}
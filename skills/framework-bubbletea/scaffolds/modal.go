@@ -0,0 +1,253 @@
+// Package screens - Modal/Overlay Scaffold
+//
+// USAGE: Copy alongside screen.go. Push a ModalModel onto a ScreenModel with
+// PushModal to interrupt the screen's normal key handling until the modal
+// resolves.
+package screens
+
+import (
+	"regexp"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/skills/framework-bubbletea/scaffolds/theme"
+)
+
+// === STYLES ===
+//
+// Each style reads theme.Current() on every call rather than being built
+// once as a package var, so a runtime theme.SetCurrent takes effect on the
+// next render.
+
+func modalStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Current().Primary).
+		Padding(1, 2)
+}
+
+func modalTitleStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Foreground(theme.Current().Primary).
+		Bold(true)
+}
+
+func modalOptionStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Foreground(theme.Current().Muted)
+}
+
+func modalOptionSelectedStyle() lipgloss.Style {
+	t := theme.Current()
+	return lipgloss.NewStyle().
+		Foreground(t.Surface).
+		Background(t.Primary).
+		Padding(0, 1)
+}
+
+// ansiSGR matches an ANSI SGR escape sequence so dimming can skip over them.
+var ansiSGR = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// === MESSAGES ===
+
+// ModalDismissedMsg is sent when a modal resolves, carrying its result.
+// Result is nil when an input or custom modal is cancelled with esc; a
+// confirm modal carries false for both n and esc.
+type ModalDismissedMsg struct {
+	Result any
+}
+
+// === MODAL KIND ===
+
+// ModalKind selects which built-in variant a ModalModel renders as.
+type ModalKind int
+
+const (
+	ModalConfirm ModalKind = iota
+	ModalInput
+	ModalCustom
+)
+
+// === MODEL ===
+
+// ModalModel is a single overlay entry. Screens push/pop a stack of these
+// via ScreenModel.PushModal/PopModal.
+type ModalModel struct {
+	Kind    ModalKind
+	Title   string
+	Message string
+
+	// ModalConfirm
+	confirmCursor int // 0 = yes, 1 = no
+
+	// ModalInput
+	inputValue string
+
+	// ModalCustom
+	Content string
+	OnKey   func(tea.KeyMsg) (ModalModel, tea.Cmd)
+}
+
+// NewConfirmModal creates a yes/no confirmation modal.
+func NewConfirmModal(title, message string) ModalModel {
+	return ModalModel{Kind: ModalConfirm, Title: title, Message: message}
+}
+
+// NewInputModal creates a single-line text input modal.
+func NewInputModal(title, message string) ModalModel {
+	return ModalModel{Kind: ModalInput, Title: title, Message: message}
+}
+
+// NewCustomModal creates a modal that renders arbitrary content and delegates
+// key handling to onKey.
+func NewCustomModal(title, content string, onKey func(tea.KeyMsg) (ModalModel, tea.Cmd)) ModalModel {
+	return ModalModel{Kind: ModalCustom, Title: title, Content: content, OnKey: onKey}
+}
+
+// Update handles a key event for the active modal, returning the (possibly
+// updated) modal plus a command. A non-nil ModalDismissedMsg command signals
+// the modal should be popped.
+func (m ModalModel) Update(msg tea.KeyMsg) (ModalModel, tea.Cmd) {
+	switch m.Kind {
+	case ModalConfirm:
+		switch msg.String() {
+		case "left", "h", "tab":
+			m.confirmCursor = 1 - m.confirmCursor
+			return m, nil
+		case "right", "l":
+			m.confirmCursor = 1 - m.confirmCursor
+			return m, nil
+		case "y":
+			return m, dismiss(true)
+		case "n", "esc":
+			return m, dismiss(false)
+		case "enter":
+			return m, dismiss(m.confirmCursor == 0)
+		}
+		return m, nil
+
+	case ModalInput:
+		switch msg.Type {
+		case tea.KeyEnter:
+			return m, dismiss(m.inputValue)
+		case tea.KeyEsc:
+			return m, dismiss(nil)
+		case tea.KeyBackspace:
+			if len(m.inputValue) > 0 {
+				m.inputValue = m.inputValue[:len(m.inputValue)-1]
+			}
+			return m, nil
+		case tea.KeyRunes:
+			m.inputValue += string(msg.Runes)
+			return m, nil
+		}
+		return m, nil
+
+	case ModalCustom:
+		if msg.Type == tea.KeyEsc {
+			return m, dismiss(nil)
+		}
+		if m.OnKey != nil {
+			return m.OnKey(msg)
+		}
+		return m, nil
+	}
+
+	return m, nil
+}
+
+func dismiss(result any) tea.Cmd {
+	return func() tea.Msg {
+		return ModalDismissedMsg{Result: result}
+	}
+}
+
+// View renders the modal's own box (without centering/dimming - the caller
+// composites it over the dimmed background).
+func (m ModalModel) View() string {
+	title := modalTitleStyle().Render(m.Title)
+
+	var body string
+	switch m.Kind {
+	case ModalConfirm:
+		yes := modalOptionStyle().Render("Yes")
+		no := modalOptionStyle().Render("No")
+		if m.confirmCursor == 0 {
+			yes = modalOptionSelectedStyle().Render("Yes")
+		} else {
+			no = modalOptionSelectedStyle().Render("No")
+		}
+		body = m.Message + "\n\n" + yes + "   " + no
+
+	case ModalInput:
+		body = m.Message + "\n\n> " + m.inputValue + "█"
+
+	case ModalCustom:
+		body = m.Content
+	}
+
+	return modalStyle().Render(lipgloss.JoinVertical(lipgloss.Left, title, "", body))
+}
+
+func dimStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(theme.Current().Muted)
+}
+
+// overlay places fg's top-left corner at column x, row y of bg, replacing the covered cell
+// range of each overlapping background line. Background and foreground runes
+// are assumed single-cell width, which holds for the ASCII/box-drawing
+// glyphs used throughout this scaffold.
+func overlay(bg, fg string, x, y int) string {
+	plain := ansiSGR.ReplaceAllString(bg, "")
+	bgLines := splitLines(plain)
+	fgLines := splitLines(fg)
+
+	out := make([]string, len(bgLines))
+	for i, line := range bgLines {
+		out[i] = dimStyle().Render(line)
+	}
+
+	for i, fgLine := range fgLines {
+		row := y + i
+		if row < 0 || row >= len(bgLines) {
+			continue
+		}
+
+		runes := []rune(bgLines[row])
+		fgWidth := lipgloss.Width(fgLine)
+		left := sliceRunes(runes, 0, x)
+		right := sliceRunes(runes, x+fgWidth, len(runes))
+
+		out[row] = dimStyle().Render(left) + fgLine + dimStyle().Render(right)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, out...)
+}
+
+// sliceRunes returns runes[start:end], clamped to valid bounds.
+func sliceRunes(runes []rune, start, end int) string {
+	if start < 0 {
+		start = 0
+	}
+	if end > len(runes) {
+		end = len(runes)
+	}
+	if start >= end {
+		return ""
+	}
+	return string(runes[start:end])
+}
+
+func splitLines(s string) []string {
+	var lines []string
+	start := 0
+	for i, r := range s {
+		if r == '\n' {
+			lines = append(lines, s[start:i])
+			start = i + 1
+		}
+	}
+	lines = append(lines, s[start:])
+	return lines
+}
@@ -2,38 +2,59 @@
 //
 // USAGE: Copy this file for each major screen in your application.
 // Screens are typically composed of multiple components and handle navigation.
+// Copy the theme/ subdirectory alongside it so colors come from
+// theme.Current() and adapt to light/dark terminals instead of being
+// hardcoded.
 package screens
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"github.com/MateoSegura/.claude/skills/framework-bubbletea/scaffolds/theme"
 )
 
 // === STYLES ===
+//
+// headerStyle/footerStyle read theme.Current() on every call rather than
+// being built once as package vars, so a runtime theme.SetCurrent takes
+// effect on the next render.
+
+func headerStyle() lipgloss.Style {
+	t := theme.Current()
+	return lipgloss.NewStyle().
+		Background(t.Surface).
+		Foreground(t.Primary).
+		Bold(true).
+		Padding(0, 1)
+}
 
-var (
-	headerStyle = lipgloss.NewStyle().
-			Background(lipgloss.Color("#1A1A1A")).
-			Foreground(lipgloss.Color("#FFD700")).
-			Bold(true).
-			Padding(0, 1)
-
-	contentStyle = lipgloss.NewStyle().
-			Padding(1, 2)
+var contentStyle = lipgloss.NewStyle().
+	Padding(1, 2)
 
-	footerStyle = lipgloss.NewStyle().
-			Background(lipgloss.Color("#1A1A1A")).
-			Foreground(lipgloss.Color("#6B6B6B")).
-			Padding(0, 1)
-)
+func footerStyle() lipgloss.Style {
+	t := theme.Current()
+	return lipgloss.NewStyle().
+		Background(t.Surface).
+		Foreground(t.Muted).
+		Padding(0, 1)
+}
 
 // === KEY BINDINGS ===
 
 type ScreenKeyMap struct {
-	Back key.Binding
-	Help key.Binding
-	Quit key.Binding
+	Back    key.Binding
+	Help    key.Binding
+	Quit    key.Binding
+	Palette key.Binding
+	Refresh key.Binding
 }
 
 var screenKeys = ScreenKeyMap{
@@ -49,6 +70,14 @@ var screenKeys = ScreenKeyMap{
 		key.WithKeys("q", "ctrl+c"),
 		key.WithHelp("q", "quit"),
 	),
+	Palette: key.NewBinding(
+		key.WithKeys(":"),
+		key.WithHelp(":", "commands"),
+	),
+	Refresh: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "refresh"),
+	),
 }
 
 // === MESSAGES ===
@@ -70,12 +99,21 @@ type ScreenErrorMsg struct {
 
 // ScreenModel represents a full-screen view.
 type ScreenModel struct {
-	title     string
-	showHelp  bool
-	loading   bool
-	err       error
-	width     int
-	height    int
+	title    string
+	showHelp bool
+	loading  bool
+	err      error
+	width    int
+	height   int
+
+	modals []ModalModel // stack; top of stack is last element
+
+	commands      map[string]CommandSpec
+	paletteActive bool
+	palette       commandPaletteModel
+
+	loadCancel context.CancelFunc // cancels the in-flight DataSource load, if any
+	reload     func() tea.Cmd     // re-invokes the last DataSource, set by SetDataSource
 
 	// Add your sub-components here:
 	// list    ListModel
@@ -83,11 +121,49 @@ type ScreenModel struct {
 	// input   textinput.Model
 }
 
+// === MODAL STACK ===
+
+// PushModal opens a modal on top of the screen, pausing normal key handling
+// until it's dismissed.
+func (m *ScreenModel) PushModal(modal ModalModel) {
+	m.modals = append(m.modals, modal)
+}
+
+// PopModal removes the top modal, if any.
+func (m *ScreenModel) PopModal() {
+	if len(m.modals) > 0 {
+		m.modals = m.modals[:len(m.modals)-1]
+	}
+}
+
+// HasModal reports whether a modal is currently active.
+func (m ScreenModel) HasModal() bool {
+	return len(m.modals) > 0
+}
+
 // NewScreenModel creates a new screen.
 func NewScreenModel(title string) ScreenModel {
-	return ScreenModel{
+	m := ScreenModel{
 		title: title,
 	}
+	m.RegisterCommand("theme", "switch the color theme (k9s-dark, solarized-light, dracula, high-contrast)",
+		[]ArgSpec{{Key: "name", Type: ArgString, Required: true}},
+		func(args CommandArgs) tea.Cmd {
+			return SetTheme(args["name"].(string))
+		})
+	return m
+}
+
+// SetTheme switches the active palette by built-in name and returns a
+// command announcing the change. Render helpers read theme.Current() on
+// every call, so the command only needs to exist to trigger a re-render.
+func SetTheme(name string) tea.Cmd {
+	return func() tea.Msg {
+		if err := theme.SetCurrentByName(name); err != nil {
+			return ScreenErrorMsg{Err: err}
+		}
+		return theme.ThemeChangedMsg{Name: name}
+	}
 }
 
 // === PUBLIC METHODS ===
@@ -113,6 +189,16 @@ func (m *ScreenModel) SetError(err error) {
 	m.err = err
 }
 
+// CancelLoad cancels the in-flight DataSource load, if any. SetDataSource
+// calls this automatically before starting a new load; screens call it
+// directly when navigating away.
+func (m *ScreenModel) CancelLoad() {
+	if m.loadCancel != nil {
+		m.loadCancel()
+		m.loadCancel = nil
+	}
+}
+
 // === TEA.MODEL INTERFACE ===
 
 // Init implements tea.Model.
@@ -130,21 +216,91 @@ func (m ScreenModel) Init() tea.Cmd {
 func (m ScreenModel) Update(msg tea.Msg) (ScreenModel, tea.Cmd) {
 	var cmds []tea.Cmd
 
+	// Modals intercept all key events first.
+	if m.HasModal() {
+		top := len(m.modals) - 1
+
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			updated, cmd := m.modals[top].Update(keyMsg)
+			m.modals[top] = updated
+			return m, cmd
+		}
+
+		if _, ok := msg.(ModalDismissedMsg); ok {
+			m.PopModal()
+			return m, nil
+		}
+
+		if sizeMsg, ok := msg.(tea.WindowSizeMsg); ok {
+			m.SetSize(sizeMsg.Width, sizeMsg.Height)
+		}
+		return m, nil
+	}
+
+	// The command palette intercepts all key events next.
+	if m.paletteActive {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			updated, cmd := m.palette.Update(keyMsg)
+			m.palette = updated
+			return m, cmd
+		}
+
+		if dismissed, ok := msg.(paletteDismissedMsg); ok {
+			m.paletteActive = false
+			if dismissed.line == "" {
+				return m, nil
+			}
+			return m, m.RunCommand(dismissed.line)
+		}
+
+		if sizeMsg, ok := msg.(tea.WindowSizeMsg); ok {
+			m.SetSize(sizeMsg.Width, sizeMsg.Height)
+		}
+		return m, nil
+	}
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.SetSize(msg.Width, msg.Height)
 		return m, nil
 
+	case ModalDismissedMsg:
+		// Modal was already popped above; nothing left to do here if it
+		// somehow arrives with no modal on the stack.
+		return m, nil
+
+	case paletteDismissedMsg:
+		// Palette was already closed above; nothing left to do here if it
+		// somehow arrives with no palette active.
+		return m, nil
+
+	case theme.ThemeChangedMsg:
+		// No state to update - render helpers read theme.Current() directly,
+		// so receiving this message is itself the re-render trigger.
+		return m, nil
+
 	case tea.KeyMsg:
 		// Global screen keys
 		switch {
 		case key.Matches(msg, screenKeys.Back):
+			m.CancelLoad()
 			return m, func() tea.Msg { return ScreenBackMsg{} }
 
 		case key.Matches(msg, screenKeys.Help):
 			m.showHelp = !m.showHelp
 			return m, nil
 
+		case key.Matches(msg, screenKeys.Palette):
+			m.palette = newCommandPalette(m.commands)
+			m.paletteActive = true
+			return m, textinput.Blink
+
+		case key.Matches(msg, screenKeys.Refresh):
+			if m.reload != nil {
+				return m, m.reload()
+			}
+			return m, nil
+
 		case key.Matches(msg, screenKeys.Quit):
 			return m, tea.Quit
 		}
@@ -197,22 +353,53 @@ func (m ScreenModel) View() string {
 		Height(contentHeight).
 		Render(content)
 
-	return lipgloss.JoinVertical(lipgloss.Left,
+	base := lipgloss.JoinVertical(lipgloss.Left,
 		header,
 		contentArea,
 		footer,
 	)
+
+	if m.HasModal() {
+		return m.renderWithModal(base)
+	}
+
+	if m.paletteActive {
+		return m.renderWithPalette(base)
+	}
+
+	return base
+}
+
+// renderWithModal dims the rendered screen and places the top modal centered
+// over it.
+func (m ScreenModel) renderWithModal(base string) string {
+	top := m.modals[len(m.modals)-1]
+	modalView := top.View()
+
+	x := (m.width - lipgloss.Width(modalView)) / 2
+	y := (m.height - lipgloss.Height(modalView)) / 2
+	return overlay(base, modalView, x, y)
+}
+
+// renderWithPalette dims the rendered screen and places the command palette
+// near the top, centered horizontally.
+func (m ScreenModel) renderWithPalette(base string) string {
+	paletteView := m.palette.View()
+
+	x := (m.width - lipgloss.Width(paletteView)) / 2
+	y := 2
+	return overlay(base, paletteView, x, y)
 }
 
 // === RENDER HELPERS ===
 
 func (m ScreenModel) renderHeader() string {
 	title := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FFD700")).
+		Foreground(theme.Current().Primary).
 		Bold(true).
 		Render(m.title)
 
-	return headerStyle.Width(m.width).Render(title)
+	return headerStyle().Width(m.width).Render(title)
 }
 
 func (m ScreenModel) renderContent() string {
@@ -236,7 +423,7 @@ func (m ScreenModel) renderLoading() string {
 
 func (m ScreenModel) renderError() string {
 	errorStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FF073A")).
+		Foreground(theme.Current().Error).
 		Bold(true)
 
 	return lipgloss.Place(
@@ -257,22 +444,42 @@ Keyboard Shortcuts:
   Tab        Switch pane
   Esc        Go back
   ?          Toggle help
+  :          Command palette
+  r          Refresh data
   q          Quit
 `
-	return helpText
+	if len(m.commands) == 0 {
+		return helpText
+	}
+
+	names := make([]string, 0, len(m.commands))
+	for name := range m.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString(helpText)
+	b.WriteString("\nCommands (:):\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "  %-12s %s\n", name, m.commands[name].Help)
+	}
+	return b.String()
 }
 
 func (m ScreenModel) renderFooter() string {
+	t := theme.Current()
+	keyStyle := lipgloss.NewStyle().Foreground(t.Primary)
+	labelStyle := lipgloss.NewStyle().Foreground(t.Muted)
+
 	hints := []string{
-		lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700")).Render("esc") +
-			lipgloss.NewStyle().Foreground(lipgloss.Color("#6B6B6B")).Render(":back"),
-		lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700")).Render("?") +
-			lipgloss.NewStyle().Foreground(lipgloss.Color("#6B6B6B")).Render(":help"),
-		lipgloss.NewStyle().Foreground(lipgloss.Color("#FFD700")).Render("q") +
-			lipgloss.NewStyle().Foreground(lipgloss.Color("#6B6B6B")).Render(":quit"),
+		keyStyle.Render("esc") + labelStyle.Render(":back"),
+		keyStyle.Render("?") + labelStyle.Render(":help"),
+		keyStyle.Render(":") + labelStyle.Render(":commands"),
+		keyStyle.Render("q") + labelStyle.Render(":quit"),
 	}
 
-	return footerStyle.Width(m.width).Render(
-		lipgloss.JoinHorizontal(lipgloss.Left, hints[0], "  ", hints[1], "  ", hints[2]),
+	return footerStyle().Width(m.width).Render(
+		lipgloss.JoinHorizontal(lipgloss.Left, hints[0], "  ", hints[1], "  ", hints[2], "  ", hints[3]),
 	)
 }
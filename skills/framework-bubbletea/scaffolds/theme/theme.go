@@ -0,0 +1,195 @@
+// Package theme - Adaptive Color Palette Scaffold
+//
+// USAGE: Copy this directory alongside screen.go/modal.go/commands.go so the
+// screens package can resolve colors through theme.Current() instead of
+// hardcoding hex values. Every slot is a lipgloss.AdaptiveColor, so a single
+// palette renders correctly on both dark and light terminal backgrounds.
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// Palette is a semantic set of colors a screen renders with. Render helpers
+// should consult Current() for one of these slots rather than embedding a
+// hex literal directly.
+type Palette struct {
+	Primary   lipgloss.AdaptiveColor
+	Secondary lipgloss.AdaptiveColor
+	Accent    lipgloss.AdaptiveColor
+	Success   lipgloss.AdaptiveColor
+	Warning   lipgloss.AdaptiveColor
+	Error     lipgloss.AdaptiveColor
+	Surface   lipgloss.AdaptiveColor
+	OnSurface lipgloss.AdaptiveColor
+	Muted     lipgloss.AdaptiveColor
+}
+
+// K9sDark mirrors the colors this scaffold used before theming existed, so
+// switching to the theme package is a no-op for anyone who doesn't opt into
+// a different palette.
+var K9sDark = Palette{
+	Primary:   lipgloss.AdaptiveColor{Light: "#B8860B", Dark: "#FFD700"},
+	Secondary: lipgloss.AdaptiveColor{Light: "#0087AF", Dark: "#00D9FF"},
+	Accent:    lipgloss.AdaptiveColor{Light: "#8700AF", Dark: "#BF00FF"},
+	Success:   lipgloss.AdaptiveColor{Light: "#008700", Dark: "#39FF14"},
+	Warning:   lipgloss.AdaptiveColor{Light: "#AF5F00", Dark: "#FF8700"},
+	Error:     lipgloss.AdaptiveColor{Light: "#AF0000", Dark: "#FF073A"},
+	Surface:   lipgloss.AdaptiveColor{Light: "#E4E4E4", Dark: "#1A1A1A"},
+	OnSurface: lipgloss.AdaptiveColor{Light: "#1A1A1A", Dark: "#FAFAFA"},
+	Muted:     lipgloss.AdaptiveColor{Light: "#6B6B6B", Dark: "#6B6B6B"},
+}
+
+// SolarizedLight is the Solarized palette, biased toward its light variant.
+var SolarizedLight = Palette{
+	Primary:   lipgloss.AdaptiveColor{Light: "#B58900", Dark: "#B58900"},
+	Secondary: lipgloss.AdaptiveColor{Light: "#268BD2", Dark: "#268BD2"},
+	Accent:    lipgloss.AdaptiveColor{Light: "#6C71C4", Dark: "#6C71C4"},
+	Success:   lipgloss.AdaptiveColor{Light: "#859900", Dark: "#859900"},
+	Warning:   lipgloss.AdaptiveColor{Light: "#CB4B16", Dark: "#CB4B16"},
+	Error:     lipgloss.AdaptiveColor{Light: "#DC322F", Dark: "#DC322F"},
+	Surface:   lipgloss.AdaptiveColor{Light: "#FDF6E3", Dark: "#FDF6E3"},
+	OnSurface: lipgloss.AdaptiveColor{Light: "#073642", Dark: "#073642"},
+	Muted:     lipgloss.AdaptiveColor{Light: "#93A1A1", Dark: "#93A1A1"},
+}
+
+// Dracula is the Dracula palette.
+var Dracula = Palette{
+	Primary:   lipgloss.AdaptiveColor{Light: "#BD93F9", Dark: "#BD93F9"},
+	Secondary: lipgloss.AdaptiveColor{Light: "#8BE9FD", Dark: "#8BE9FD"},
+	Accent:    lipgloss.AdaptiveColor{Light: "#FF79C6", Dark: "#FF79C6"},
+	Success:   lipgloss.AdaptiveColor{Light: "#50FA7B", Dark: "#50FA7B"},
+	Warning:   lipgloss.AdaptiveColor{Light: "#FFB86C", Dark: "#FFB86C"},
+	Error:     lipgloss.AdaptiveColor{Light: "#FF5555", Dark: "#FF5555"},
+	Surface:   lipgloss.AdaptiveColor{Light: "#282A36", Dark: "#282A36"},
+	OnSurface: lipgloss.AdaptiveColor{Light: "#F8F8F2", Dark: "#F8F8F2"},
+	Muted:     lipgloss.AdaptiveColor{Light: "#6272A4", Dark: "#6272A4"},
+}
+
+// HighContrast maximizes contrast between Surface and OnSurface for
+// accessibility, at the cost of the subtler accents the other palettes use.
+var HighContrast = Palette{
+	Primary:   lipgloss.AdaptiveColor{Light: "#000000", Dark: "#FFFFFF"},
+	Secondary: lipgloss.AdaptiveColor{Light: "#0000FF", Dark: "#00FFFF"},
+	Accent:    lipgloss.AdaptiveColor{Light: "#AA00AA", Dark: "#FF00FF"},
+	Success:   lipgloss.AdaptiveColor{Light: "#006600", Dark: "#00FF00"},
+	Warning:   lipgloss.AdaptiveColor{Light: "#996600", Dark: "#FFFF00"},
+	Error:     lipgloss.AdaptiveColor{Light: "#CC0000", Dark: "#FF0000"},
+	Surface:   lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#000000"},
+	OnSurface: lipgloss.AdaptiveColor{Light: "#000000", Dark: "#FFFFFF"},
+	Muted:     lipgloss.AdaptiveColor{Light: "#666666", Dark: "#999999"},
+}
+
+// Named maps built-in theme names to their palette, for SetCurrentByName and
+// command-line/config lookups.
+var Named = map[string]Palette{
+	"k9s-dark":        K9sDark,
+	"solarized-light": SolarizedLight,
+	"dracula":         Dracula,
+	"high-contrast":   HighContrast,
+}
+
+// current is the active palette. It starts as K9sDark so existing screens
+// keep their current look until something calls SetCurrent.
+var current = K9sDark
+
+// Current returns the active palette. Render helpers call this instead of
+// embedding hex colors directly.
+func Current() Palette {
+	return current
+}
+
+// SetCurrent replaces the active palette.
+func SetCurrent(p Palette) {
+	current = p
+}
+
+// SetCurrentByName replaces the active palette with a built-in theme,
+// returning an error if name isn't registered in Named.
+func SetCurrentByName(name string) error {
+	p, ok := Named[name]
+	if !ok {
+		return fmt.Errorf("theme: unknown theme %q", name)
+	}
+	current = p
+	return nil
+}
+
+// ThemeChangedMsg signals that the active palette changed. Screens should
+// handle it as a no-op tea.Msg case: View() reads theme.Current() on every
+// render, so the message only needs to exist to trigger that re-render.
+type ThemeChangedMsg struct {
+	Name string
+}
+
+// fileColor is the on-disk shape of a Palette slot. Light falls back to
+// Dark when omitted, so a palette file can target dark terminals only;
+// Load does not check that Dark is set.
+type fileColor struct {
+	Dark  string `json:"dark" yaml:"dark"`
+	Light string `json:"light" yaml:"light"`
+}
+
+func (c fileColor) adaptive() lipgloss.AdaptiveColor {
+	light := c.Light
+	if light == "" {
+		light = c.Dark
+	}
+	return lipgloss.AdaptiveColor{Light: light, Dark: c.Dark}
+}
+
+// filePalette is the on-disk shape of a Palette.
+type filePalette struct {
+	Primary   fileColor `json:"primary" yaml:"primary"`
+	Secondary fileColor `json:"secondary" yaml:"secondary"`
+	Accent    fileColor `json:"accent" yaml:"accent"`
+	Success   fileColor `json:"success" yaml:"success"`
+	Warning   fileColor `json:"warning" yaml:"warning"`
+	Error     fileColor `json:"error" yaml:"error"`
+	Surface   fileColor `json:"surface" yaml:"surface"`
+	OnSurface fileColor `json:"on_surface" yaml:"on_surface"`
+	Muted     fileColor `json:"muted" yaml:"muted"`
+}
+
+// Load reads a Palette from a JSON or YAML file, selected by extension
+// (.json, or .yaml/.yml - this repository already depends on gopkg.in/yaml.v3
+// elsewhere, so YAML stands in for the TOML format other palette-loading
+// tools use).
+func Load(path string) (Palette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Palette{}, fmt.Errorf("theme: read %s: %w", path, err)
+	}
+
+	var fp filePalette
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		err = json.Unmarshal(data, &fp)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &fp)
+	default:
+		return Palette{}, fmt.Errorf("theme: unsupported palette format %q", ext)
+	}
+	if err != nil {
+		return Palette{}, fmt.Errorf("theme: parse %s: %w", path, err)
+	}
+
+	return Palette{
+		Primary:   fp.Primary.adaptive(),
+		Secondary: fp.Secondary.adaptive(),
+		Accent:    fp.Accent.adaptive(),
+		Success:   fp.Success.adaptive(),
+		Warning:   fp.Warning.adaptive(),
+		Error:     fp.Error.adaptive(),
+		Surface:   fp.Surface.adaptive(),
+		OnSurface: fp.OnSurface.adaptive(),
+		Muted:     fp.Muted.adaptive(),
+	}, nil
+}
@@ -5,48 +5,19 @@
 package screens
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-)
-
-// =============================================================================
-// THEME (inline for scaffold - use your theme package in real code)
-// =============================================================================
 
-var (
-	formColorGold      = lipgloss.Color("#FFD700")
-	formColorBlack     = lipgloss.Color("#0A0A0A")
-	formColorBlackLight = lipgloss.Color("#1A1A1A")
-	formColorWhite     = lipgloss.Color("#FAFAFA")
-	formColorGray      = lipgloss.Color("#6B6B6B")
-	formColorGrayDark  = lipgloss.Color("#3D3D3D")
-	formColorCharcoal  = lipgloss.Color("#252525")
-	formColorRed       = lipgloss.Color("#FF073A")
-)
-
-// =============================================================================
-// STYLES
-// =============================================================================
-
-var (
-	formInputStyle = lipgloss.NewStyle().
-		Border(lipgloss.NormalBorder()).
-		BorderForeground(formColorCharcoal).
-		Padding(0, 1)
-
-	formInputFocusedStyle = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(formColorGold).
-		Padding(0, 1)
-
-	formInputErrorStyle = lipgloss.NewStyle().
-		Border(lipgloss.RoundedBorder()).
-		BorderForeground(formColorRed).
-		Padding(0, 1)
+	"github.com/MateoSegura/.claude/theme"
 )
 
 // =============================================================================
@@ -55,46 +26,164 @@ var (
 
 // FormSubmittedMsg is sent when the form is successfully submitted.
 type FormSubmittedMsg struct {
-	Values map[string]string
+	Values map[string]any
 }
 
 // FormCancelledMsg is sent when the form is cancelled.
 type FormCancelledMsg struct{}
 
+// FieldValidationMsg carries the result of a field's AsyncValidator back to
+// FormScreenModel's Update, which surfaces it via SetFieldError.
+type FieldValidationMsg struct {
+	Key string
+	Err error
+}
+
 // =============================================================================
 // KEY BINDINGS
 // =============================================================================
 
-type formKeyMap struct {
-	Next   key.Binding
-	Prev   key.Binding
-	Submit key.Binding
-	Cancel key.Binding
+// fieldKeys are the fixed keys used to navigate within the focused field
+// itself (move the cursor in a FieldSelect/FieldRadio/FieldMultiSelect,
+// toggle a FieldMultiSelect option). These aren't form-level actions, so
+// they sit outside FormKeyMap/ParseBindings.
+type fieldKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Toggle key.Binding
 }
 
-var formKeys = formKeyMap{
-	Next: key.NewBinding(
-		key.WithKeys("tab"),
-		key.WithHelp("tab", "next"),
+var fieldKeys = fieldKeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("up", "k"),
+		key.WithHelp("↑/k", "move"),
 	),
-	Prev: key.NewBinding(
-		key.WithKeys("shift+tab"),
-		key.WithHelp("shift+tab", "prev"),
+	Down: key.NewBinding(
+		key.WithKeys("down", "j"),
+		key.WithHelp("↓/j", "move"),
 	),
-	Submit: key.NewBinding(
-		key.WithKeys("enter"),
-		key.WithHelp("enter", "submit"),
-	),
-	Cancel: key.NewBinding(
-		key.WithKeys("esc"),
-		key.WithHelp("esc", "cancel"),
+	Toggle: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "toggle"),
 	),
 }
 
+// FormAction is a form-level command a key can be bound to. New actions can
+// be added here, with a default binding in DefaultFormKeyMap, without
+// changing FormKeyMap's shape.
+type FormAction int
+
+const (
+	ActionNext FormAction = iota
+	ActionPrev
+	ActionSubmit
+	ActionCancel
+	ActionClearField
+	ActionFirstField
+	ActionLastField
+)
+
+// actionNames maps the short names ParseBindings accepts to a FormAction.
+var actionNames = map[string]FormAction{
+	"next":   ActionNext,
+	"prev":   ActionPrev,
+	"submit": ActionSubmit,
+	"cancel": ActionCancel,
+	"clear":  ActionClearField,
+	"first":  ActionFirstField,
+	"last":   ActionLastField,
+}
+
+// actionLabels is the footer description shown for each action's binding.
+var actionLabels = map[FormAction]string{
+	ActionNext:       "Next",
+	ActionPrev:       "Prev",
+	ActionSubmit:     "Submit",
+	ActionCancel:     "Cancel",
+	ActionClearField: "Clear",
+	ActionFirstField: "First",
+	ActionLastField:  "Last",
+}
+
+// FormKeyMap maps each FormAction to the key.Binding that triggers it.
+// Actions with no entry are unbound.
+type FormKeyMap map[FormAction]key.Binding
+
+// DefaultFormKeyMap is the binding set NewFormScreen starts with: tab/
+// shift+tab to move, enter or ctrl+s to submit, esc to cancel.
+func DefaultFormKeyMap() FormKeyMap {
+	return FormKeyMap{
+		ActionNext: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "next"),
+		),
+		ActionPrev: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "prev"),
+		),
+		// ctrl+s always submits, even while a textarea field is focused and
+		// "enter" is busy inserting a newline there.
+		ActionSubmit: key.NewBinding(
+			key.WithKeys("enter", "ctrl+s"),
+			key.WithHelp("enter", "submit"),
+		),
+		ActionCancel: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+	}
+}
+
+// ParseBindings parses a comma-separated "action:key" spec, e.g.
+// "submit:ctrl+s,cancel:ctrl+c,next:down,prev:up", into a FormKeyMap
+// suitable for SetKeyMap. Recognized actions are next, prev, submit,
+// cancel, clear, first, and last.
+func ParseBindings(spec string) (FormKeyMap, error) {
+	km := FormKeyMap{}
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return km, nil
+	}
+
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid binding %q: want action:key", pair)
+		}
+		actionName := strings.TrimSpace(parts[0])
+		keyName := strings.TrimSpace(parts[1])
+
+		action, ok := actionNames[actionName]
+		if !ok {
+			return nil, fmt.Errorf("unknown action %q", actionName)
+		}
+		km[action] = key.NewBinding(key.WithKeys(keyName), key.WithHelp(keyName, actionLabels[action]))
+	}
+
+	return km, nil
+}
+
 // =============================================================================
 // FIELD DEFINITION
 // =============================================================================
 
+// FieldType selects how a FormField is edited and rendered.
+type FieldType int
+
+const (
+	FieldText FieldType = iota
+	FieldPassword
+	FieldNumber
+	FieldTextArea
+	FieldSelect
+	FieldRadio
+	FieldMultiSelect
+)
+
 // FormField defines a form field.
 type FormField struct {
 	Key         string // Unique key for this field
@@ -102,8 +191,41 @@ type FormField struct {
 	Placeholder string
 	Required    bool
 	Width       int
-	input       textinput.Model
-	error       string
+	Type        FieldType
+
+	// Options lists the choices for FieldSelect, FieldRadio, and
+	// FieldMultiSelect.
+	Options []string
+
+	// Min/Max bound a FieldNumber field's value. Only enforced when the
+	// matching HasMin/HasMax flag is set.
+	Min, Max       float64
+	HasMin, HasMax bool
+
+	// EchoMode controls character masking for FieldText/FieldPassword.
+	// Defaults to textinput.EchoPassword for FieldPassword when left unset.
+	EchoMode textinput.EchoMode
+
+	// Validator runs synchronously during validate(), after the built-in
+	// Required/Number checks pass. It only applies to fields with a single
+	// string value (FieldText, FieldPassword, FieldNumber, FieldTextArea) —
+	// Select/Radio/MultiSelect are skipped. allValues is the form's current
+	// GetValues(), for validators that need to compare against another field.
+	Validator func(value string, allValues map[string]any) error
+
+	// AsyncValidator runs when the field loses focus (via Next/Prev), for
+	// checks that need to hit the network or disk, e.g. "is this username
+	// taken?". The returned tea.Cmd must resolve to a FieldValidationMsg for
+	// this field's Key; FormScreenModel's Update forwards that into
+	// SetFieldError automatically.
+	AsyncValidator func(ctx context.Context, value string) tea.Cmd
+
+	input    textinput.Model
+	textarea textarea.Model
+	cursor   int          // FieldSelect/FieldRadio: selected option; FieldMultiSelect: highlighted option
+	selected map[int]bool // FieldMultiSelect: set of chosen option indices
+
+	error string
 }
 
 // =============================================================================
@@ -118,38 +240,110 @@ type FormScreenModel struct {
 	width      int
 	height     int
 	helperText string
+	theme      *theme.Theme
+	keys       FormKeyMap
+
+	// onSubmit/onCancel let a parent program run its own side-effecting
+	// tea.Cmd (e.g. save to a database) instead of routing through
+	// FormSubmittedMsg/FormCancelledMsg. Set via WithOnSubmit/WithOnCancel;
+	// when unset, the form falls back to the message-based behavior.
+	onSubmit func(map[string]any) tea.Cmd
+	onCancel func() tea.Cmd
+
+	// initial is the values() snapshot to compare against for Dirty(), and
+	// the baseline Reset() restores. NewFormScreen and SetValues both
+	// refresh it, so pre-populating an edit form doesn't count as "dirty".
+	initial map[string]any
 }
 
-// NewFormScreen creates a new form screen.
+// NewFormScreen creates a new form screen, styled with theme.Default() until
+// SetTheme says otherwise.
 func NewFormScreen(title string, fields []FormField) FormScreenModel {
-	// Initialize text inputs for each field
+	t := theme.Default()
+
 	for i := range fields {
-		ti := textinput.New()
-		ti.Placeholder = fields[i].Placeholder
-		ti.Prompt = ""
-		ti.Width = fields[i].Width
-		if fields[i].Width == 0 {
-			ti.Width = 40
+		width := fields[i].Width
+		if width == 0 {
+			width = 40
 		}
-		ti.CharLimit = 256
-		ti.TextStyle = lipgloss.NewStyle().Foreground(formColorWhite)
-		ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(formColorGrayDark)
-		ti.Cursor.Style = lipgloss.NewStyle().Foreground(formColorGold)
 
-		if i == 0 {
-			ti.Focus()
-		}
+		switch fields[i].Type {
+		case FieldTextArea:
+			ta := textarea.New()
+			ta.Placeholder = fields[i].Placeholder
+			ta.SetWidth(width)
+			ta.SetHeight(3)
+			ta.CharLimit = 0
+			if i == 0 {
+				ta.Focus()
+			}
+			fields[i].textarea = ta
+
+		case FieldSelect, FieldRadio:
+			// cursor already defaults to 0, selecting the first option.
+
+		case FieldMultiSelect:
+			fields[i].selected = make(map[int]bool)
+
+		default: // FieldText, FieldPassword, FieldNumber
+			echo := fields[i].EchoMode
+			if fields[i].Type == FieldPassword && echo == textinput.EchoNormal {
+				echo = textinput.EchoPassword
+			}
+
+			ti := textinput.New()
+			ti.Placeholder = fields[i].Placeholder
+			ti.Prompt = ""
+			ti.Width = width
+			ti.CharLimit = 256
+			ti.EchoMode = echo
+			ti.PlaceholderStyle = lipgloss.NewStyle().Foreground(t.Muted)
+			ti.Cursor.Style = lipgloss.NewStyle().Foreground(t.Primary)
+
+			if i == 0 {
+				ti.Focus()
+			}
 
-		fields[i].input = ti
+			fields[i].input = ti
+		}
 	}
 
-	return FormScreenModel{
+	m := FormScreenModel{
 		title:      title,
 		fields:     fields,
 		focusIndex: 0,
+		theme:      t,
+		keys:       DefaultFormKeyMap(),
+	}
+	m.initial = m.GetValues()
+	return m
+}
+
+// SetKeyMap merges km onto the current bindings (DefaultFormKeyMap until
+// this is called), so passing a partial map from ParseBindings only
+// rebinds the actions it mentions and leaves the rest at their default.
+func (m *FormScreenModel) SetKeyMap(km FormKeyMap) {
+	for action, binding := range km {
+		m.keys[action] = binding
 	}
 }
 
+// WithOnSubmit registers fn to run instead of emitting FormSubmittedMsg when
+// the form validates successfully on Submit. Returning a non-nil tea.Cmd
+// lets the parent program chain its own side effect (e.g. writing to a
+// database) directly off submission.
+func (m FormScreenModel) WithOnSubmit(fn func(map[string]any) tea.Cmd) FormScreenModel {
+	m.onSubmit = fn
+	return m
+}
+
+// WithOnCancel registers fn to run instead of emitting FormCancelledMsg when
+// the form is cancelled.
+func (m FormScreenModel) WithOnCancel(fn func() tea.Cmd) FormScreenModel {
+	m.onCancel = fn
+	return m
+}
+
 // SetHelperText sets the helper text shown below the form.
 func (m *FormScreenModel) SetHelperText(text string) {
 	m.helperText = text
@@ -161,15 +355,149 @@ func (m *FormScreenModel) SetSize(width, height int) {
 	m.height = height
 }
 
-// GetValues returns a map of field keys to values.
-func (m FormScreenModel) GetValues() map[string]string {
-	values := make(map[string]string)
+// SetTheme replaces the palette fields render with, including the cursor and
+// placeholder colors of already-constructed text inputs.
+func (m *FormScreenModel) SetTheme(t *theme.Theme) {
+	m.theme = t
+	for i := range m.fields {
+		if m.fields[i].Type == FieldTextArea || m.fields[i].Type == FieldSelect ||
+			m.fields[i].Type == FieldRadio || m.fields[i].Type == FieldMultiSelect {
+			continue
+		}
+		m.fields[i].input.PlaceholderStyle = lipgloss.NewStyle().Foreground(t.Muted)
+		m.fields[i].input.Cursor.Style = lipgloss.NewStyle().Foreground(t.Primary)
+	}
+}
+
+// SetValues pre-populates fields from values, keyed by FormField.Key, and
+// establishes them as the new Dirty()/Reset() baseline — so loading data
+// into an edit form doesn't itself count as a change.
+func (m *FormScreenModel) SetValues(values map[string]any) {
+	for i := range m.fields {
+		if v, ok := values[m.fields[i].Key]; ok {
+			m.fields[i].setValue(v)
+		}
+	}
+	m.initial = m.GetValues()
+}
+
+// SetFieldError sets the error message shown under the field with the given
+// Key, for surfacing server-side failures (e.g. from an OnSubmit cmd) on the
+// right field instead of just the header.
+func (m *FormScreenModel) SetFieldError(key, msg string) {
+	for i := range m.fields {
+		if m.fields[i].Key == key {
+			m.fields[i].error = msg
+			return
+		}
+	}
+}
+
+// Dirty reports whether any field's value has changed since the form was
+// constructed, or since the last SetValues/Reset.
+func (m FormScreenModel) Dirty() bool {
+	return !reflect.DeepEqual(m.GetValues(), m.initial)
+}
+
+// Reset restores every field to its Dirty()/SetValues baseline and clears
+// all field errors.
+func (m *FormScreenModel) Reset() {
+	for i := range m.fields {
+		if v, ok := m.initial[m.fields[i].Key]; ok {
+			m.fields[i].setValue(v)
+		}
+		m.fields[i].error = ""
+	}
+}
+
+// GetValues returns a map of field keys to values. The concrete type depends
+// on the field: string for FieldText/FieldPassword/FieldTextArea/FieldSelect/
+// FieldRadio, float64 for FieldNumber, and []string for FieldMultiSelect.
+func (m FormScreenModel) GetValues() map[string]any {
+	values := make(map[string]any)
 	for _, f := range m.fields {
-		values[f.Key] = strings.TrimSpace(f.input.Value())
+		values[f.Key] = f.value()
 	}
 	return values
 }
 
+// value returns this field's current value in its natural Go type.
+func (f FormField) value() any {
+	switch f.Type {
+	case FieldNumber:
+		n, _ := strconv.ParseFloat(strings.TrimSpace(f.input.Value()), 64)
+		return n
+
+	case FieldTextArea:
+		return strings.TrimSpace(f.textarea.Value())
+
+	case FieldSelect, FieldRadio:
+		if f.cursor >= 0 && f.cursor < len(f.Options) {
+			return f.Options[f.cursor]
+		}
+		return ""
+
+	case FieldMultiSelect:
+		var chosen []string
+		for i, opt := range f.Options {
+			if f.selected[i] {
+				chosen = append(chosen, opt)
+			}
+		}
+		return chosen
+
+	default: // FieldText, FieldPassword
+		return strings.TrimSpace(f.input.Value())
+	}
+}
+
+// setValue applies v, in the same type value() would return for this
+// field's Type, to the underlying input. Unrecognized values are rendered
+// via fmt.Sprint rather than rejected.
+func (f *FormField) setValue(v any) {
+	switch f.Type {
+	case FieldTextArea:
+		f.textarea.SetValue(fmt.Sprint(v))
+
+	case FieldSelect, FieldRadio:
+		s := fmt.Sprint(v)
+		for i, opt := range f.Options {
+			if opt == s {
+				f.cursor = i
+				break
+			}
+		}
+
+	case FieldMultiSelect:
+		f.selected = make(map[int]bool)
+		if chosen, ok := v.([]string); ok {
+			for _, s := range chosen {
+				for i, opt := range f.Options {
+					if opt == s {
+						f.selected[i] = true
+					}
+				}
+			}
+		}
+
+	default: // FieldText, FieldPassword, FieldNumber
+		f.input.SetValue(fmt.Sprint(v))
+	}
+}
+
+// rawStringValue returns the field's current value as unparsed text, for
+// Validator/AsyncValidator, which only support single-string fields.
+func (f FormField) rawStringValue() (string, bool) {
+	switch f.Type {
+	case FieldSelect, FieldRadio, FieldMultiSelect:
+		return "", false
+	case FieldTextArea:
+		return f.textarea.Value(), true
+	default: // FieldText, FieldPassword, FieldNumber
+		return f.input.Value(), true
+	}
+}
+
 // =============================================================================
 // TEA.MODEL IMPLEMENTATION
 // =============================================================================
@@ -184,45 +512,172 @@ func (m FormScreenModel) Update(msg tea.Msg) (FormScreenModel, tea.Cmd) {
 		m.SetSize(msg.Width, msg.Height)
 		return m, nil
 
+	case FieldValidationMsg:
+		m.SetFieldError(msg.Key, errText(msg.Err))
+		return m, nil
+
 	case tea.KeyMsg:
 		switch {
-		case key.Matches(msg, formKeys.Next):
+		case key.Matches(msg, m.keys[ActionNext]):
+			asyncCmd := m.triggerAsyncValidate(m.focusIndex)
 			m.focusIndex = (m.focusIndex + 1) % len(m.fields)
 			m.updateFocus()
-			return m, textinput.Blink
+			return m, tea.Batch(textinput.Blink, asyncCmd)
 
-		case key.Matches(msg, formKeys.Prev):
+		case key.Matches(msg, m.keys[ActionPrev]):
+			asyncCmd := m.triggerAsyncValidate(m.focusIndex)
 			m.focusIndex = (m.focusIndex - 1 + len(m.fields)) % len(m.fields)
 			m.updateFocus()
-			return m, textinput.Blink
+			return m, tea.Batch(textinput.Blink, asyncCmd)
 
-		case key.Matches(msg, formKeys.Submit):
-			if m.validate() {
-				return m, func() tea.Msg {
-					return FormSubmittedMsg{Values: m.GetValues()}
-				}
-			}
+		case key.Matches(msg, m.keys[ActionFirstField]):
+			asyncCmd := m.triggerAsyncValidate(m.focusIndex)
+			m.focusIndex = 0
+			m.updateFocus()
+			return m, tea.Batch(textinput.Blink, asyncCmd)
+
+		case key.Matches(msg, m.keys[ActionLastField]):
+			asyncCmd := m.triggerAsyncValidate(m.focusIndex)
+			m.focusIndex = len(m.fields) - 1
+			m.updateFocus()
+			return m, tea.Batch(textinput.Blink, asyncCmd)
+
+		case key.Matches(msg, m.keys[ActionClearField]):
+			m.clearFocusedField()
 			return m, nil
 
-		case key.Matches(msg, formKeys.Cancel):
+		case key.Matches(msg, m.keys[ActionCancel]):
+			if m.onCancel != nil {
+				return m, m.onCancel()
+			}
 			return m, func() tea.Msg {
 				return FormCancelledMsg{}
 			}
+
+		case key.Matches(msg, m.keys[ActionSubmit]):
+			// A bare "enter" on a focused textarea inserts a newline rather
+			// than submitting; ctrl+s (also bound to Submit) always submits.
+			if m.fields[m.focusIndex].Type != FieldTextArea || msg.String() != "enter" {
+				if m.validate() {
+					values := m.GetValues()
+					if m.onSubmit != nil {
+						return m, m.onSubmit(values)
+					}
+					return m, func() tea.Msg {
+						return FormSubmittedMsg{Values: values}
+					}
+				}
+				return m, nil
+			}
 		}
+
+		return m, m.updateFocusedField(msg)
+	}
+
+	return m, nil
+}
+
+// clearFocusedField resets the focused field to its zero value.
+func (m *FormScreenModel) clearFocusedField() {
+	f := &m.fields[m.focusIndex]
+	switch f.Type {
+	case FieldTextArea:
+		f.textarea.SetValue("")
+	case FieldSelect, FieldRadio:
+		f.cursor = 0
+	case FieldMultiSelect:
+		f.selected = make(map[int]bool)
+	default: // FieldText, FieldPassword, FieldNumber
+		f.input.SetValue("")
+	}
+	f.error = ""
+}
+
+// triggerAsyncValidate runs the AsyncValidator for the field at i, if any,
+// against its current raw value. Called when a field loses focus.
+func (m FormScreenModel) triggerAsyncValidate(i int) tea.Cmd {
+	f := m.fields[i]
+	if f.AsyncValidator == nil {
+		return nil
+	}
+	value, ok := f.rawStringValue()
+	if !ok {
+		return nil
+	}
+	return f.AsyncValidator(context.Background(), value)
+}
+
+// errText renders err as a field error string, or "" when err is nil.
+func errText(err error) string {
+	if err == nil {
+		return ""
 	}
+	return err.Error()
+}
+
+// updateFocusedField routes a key event to the focused field according to
+// its Type.
+func (m *FormScreenModel) updateFocusedField(msg tea.KeyMsg) tea.Cmd {
+	f := &m.fields[m.focusIndex]
+
+	switch f.Type {
+	case FieldRadio, FieldSelect:
+		if len(f.Options) == 0 {
+			return nil
+		}
+		switch {
+		case key.Matches(msg, fieldKeys.Up):
+			f.cursor = (f.cursor - 1 + len(f.Options)) % len(f.Options)
+		case key.Matches(msg, fieldKeys.Down):
+			f.cursor = (f.cursor + 1) % len(f.Options)
+		}
+		return nil
+
+	case FieldMultiSelect:
+		if len(f.Options) == 0 {
+			return nil
+		}
+		switch {
+		case key.Matches(msg, fieldKeys.Up):
+			f.cursor = (f.cursor - 1 + len(f.Options)) % len(f.Options)
+		case key.Matches(msg, fieldKeys.Down):
+			f.cursor = (f.cursor + 1) % len(f.Options)
+		case key.Matches(msg, fieldKeys.Toggle):
+			f.selected[f.cursor] = !f.selected[f.cursor]
+		}
+		return nil
 
-	// Forward to focused input
-	var cmd tea.Cmd
-	m.fields[m.focusIndex].input, cmd = m.fields[m.focusIndex].input.Update(msg)
-	return m, cmd
+	case FieldTextArea:
+		var cmd tea.Cmd
+		f.textarea, cmd = f.textarea.Update(msg)
+		return cmd
+
+	default: // FieldText, FieldPassword, FieldNumber
+		var cmd tea.Cmd
+		f.input, cmd = f.input.Update(msg)
+		return cmd
+	}
 }
 
 func (m *FormScreenModel) updateFocus() {
 	for i := range m.fields {
-		if i == m.focusIndex {
-			m.fields[i].input.Focus()
-		} else {
-			m.fields[i].input.Blur()
+		focused := i == m.focusIndex
+		switch m.fields[i].Type {
+		case FieldTextArea:
+			if focused {
+				m.fields[i].textarea.Focus()
+			} else {
+				m.fields[i].textarea.Blur()
+			}
+		case FieldSelect, FieldRadio, FieldMultiSelect:
+			// No sub-component to focus; rendering highlights the active
+			// field directly from m.focusIndex.
+		default:
+			if focused {
+				m.fields[i].input.Focus()
+			} else {
+				m.fields[i].input.Blur()
+			}
 		}
 	}
 }
@@ -230,11 +685,64 @@ func (m *FormScreenModel) updateFocus() {
 func (m *FormScreenModel) validate() bool {
 	valid := true
 	for i := range m.fields {
-		m.fields[i].error = ""
-		value := strings.TrimSpace(m.fields[i].input.Value())
-		if m.fields[i].Required && value == "" {
-			m.fields[i].error = "This field is required"
-			valid = false
+		f := &m.fields[i]
+		f.error = ""
+
+		switch f.Type {
+		case FieldNumber:
+			raw := strings.TrimSpace(f.input.Value())
+			if raw == "" {
+				if f.Required {
+					f.error = "This field is required"
+					valid = false
+				}
+				continue
+			}
+			n, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				f.error = "Must be a number"
+				valid = false
+				continue
+			}
+			if f.HasMin && n < f.Min {
+				f.error = fmt.Sprintf("Must be at least %g", f.Min)
+				valid = false
+			}
+			if f.HasMax && n > f.Max {
+				f.error = fmt.Sprintf("Must be at most %g", f.Max)
+				valid = false
+			}
+
+		case FieldTextArea:
+			if f.Required && strings.TrimSpace(f.textarea.Value()) == "" {
+				f.error = "This field is required"
+				valid = false
+			}
+
+		case FieldMultiSelect:
+			if f.Required && len(f.value().([]string)) == 0 {
+				f.error = "Select at least one option"
+				valid = false
+			}
+
+		case FieldSelect, FieldRadio:
+			// A cursor always points at a valid option once Options is
+			// non-empty, so there's nothing to require here.
+
+		default: // FieldText, FieldPassword
+			if f.Required && strings.TrimSpace(f.input.Value()) == "" {
+				f.error = "This field is required"
+				valid = false
+			}
+		}
+
+		if f.error == "" && f.Validator != nil {
+			if raw, ok := f.rawStringValue(); ok {
+				if err := f.Validator(raw, m.GetValues()); err != nil {
+					f.error = err.Error()
+					valid = false
+				}
+			}
 		}
 	}
 	return valid
@@ -269,48 +777,52 @@ func (m FormScreenModel) View() string {
 
 func (m FormScreenModel) renderHeader() string {
 	left := lipgloss.NewStyle().
-		Foreground(formColorGold).
+		Foreground(m.theme.Primary).
 		Bold(true).
 		Render("â—† " + m.title)
 
-	return lipgloss.NewStyle().
-		Background(formColorBlackLight).
+	return m.theme.HeaderStyle().
 		Width(m.width).
-		Padding(0, 1).
 		Render(left)
 }
 
+// footerActions is the order (and subset) of actions renderFooter shows, so
+// rebinding a key updates the footer without anyone having to edit it.
+var footerActions = []FormAction{ActionNext, ActionSubmit, ActionCancel}
+
 func (m FormScreenModel) renderFooter() string {
-	shortcuts := []string{
-		renderFormShortcut("Tab", "Next"),
-		renderFormShortcut("Enter", "Submit"),
-		renderFormShortcut("Esc", "Cancel"),
+	var shortcuts []string
+	for _, action := range footerActions {
+		b, ok := m.keys[action]
+		if !ok || len(b.Keys()) == 0 {
+			continue
+		}
+		help := b.Help()
+		shortcuts = append(shortcuts, m.renderFormShortcut(help.Key, help.Desc))
 	}
 
-	return lipgloss.NewStyle().
-		Background(formColorBlackLight).
+	return m.theme.FooterStyle().
 		Width(m.width).
-		Padding(0, 1).
 		Render(strings.Join(shortcuts, "  "))
 }
 
-func renderFormShortcut(key, desc string) string {
-	k := lipgloss.NewStyle().Foreground(formColorGold).Bold(true).Render("<" + key + ">")
-	d := lipgloss.NewStyle().Foreground(formColorGray).Render(desc)
+func (m FormScreenModel) renderFormShortcut(key, desc string) string {
+	k := m.theme.ShortcutKeyStyle().Render("<" + key + ">")
+	d := lipgloss.NewStyle().Foreground(m.theme.Muted).Render(desc)
 	return k + d
 }
 
 func (m FormScreenModel) renderForm() string {
 	// Title
 	title := lipgloss.NewStyle().
-		Foreground(formColorGold).
+		Foreground(m.theme.Primary).
 		Bold(true).
 		Render(m.title)
 
 	// Fields
 	var fieldViews []string
 	labelStyle := lipgloss.NewStyle().
-		Foreground(formColorGray).
+		Foreground(m.theme.Muted).
 		Width(12).
 		Align(lipgloss.Right)
 
@@ -318,22 +830,26 @@ func (m FormScreenModel) renderForm() string {
 		label := labelStyle.Render(f.Label)
 
 		// Determine input style
-		inputStyle := formInputStyle
+		inputStyle := m.theme.InputStyle()
 		if i == m.focusIndex {
-			inputStyle = formInputFocusedStyle
+			inputStyle = m.theme.InputFocusedStyle()
 		}
 		if f.error != "" {
-			inputStyle = formInputErrorStyle
+			inputStyle = m.theme.InputErrorStyle()
 		}
 
-		inputView := inputStyle.Width(f.Width + 4).Render(f.input.View())
+		width := f.Width
+		if width == 0 {
+			width = 40
+		}
+		inputView := inputStyle.Width(width + 4).Render(m.renderFieldValue(f, i == m.focusIndex))
 
 		row := lipgloss.JoinHorizontal(lipgloss.Left, label, "  ", inputView)
 
 		// Add error message if present
 		if f.error != "" {
 			errStyle := lipgloss.NewStyle().
-				Foreground(formColorRed).
+				Foreground(m.theme.Error).
 				MarginLeft(14)
 			row = lipgloss.JoinVertical(lipgloss.Left, row, errStyle.Render(f.error))
 		}
@@ -345,7 +861,7 @@ func (m FormScreenModel) renderForm() string {
 	var helperView string
 	if m.helperText != "" {
 		helperView = lipgloss.NewStyle().
-			Foreground(formColorGrayDark).
+			Foreground(m.theme.Muted).
 			Render(m.helperText)
 	}
 
@@ -356,3 +872,50 @@ func (m FormScreenModel) renderForm() string {
 		helperView,
 	)
 }
+
+// renderFieldValue renders a field's editable value according to its Type.
+// FieldText/FieldPassword/FieldNumber delegate straight to their
+// textinput.Model (which already masks FieldPassword via EchoMode).
+func (m FormScreenModel) renderFieldValue(f FormField, focused bool) string {
+	switch f.Type {
+	case FieldTextArea:
+		return f.textarea.View()
+
+	case FieldSelect:
+		if len(f.Options) == 0 {
+			return "(no options)"
+		}
+		return fmt.Sprintf("‹ %s ›", f.Options[f.cursor])
+
+	case FieldRadio:
+		var lines []string
+		for i, opt := range f.Options {
+			marker := "(o)"
+			style := lipgloss.NewStyle().Foreground(m.theme.Muted)
+			if i == f.cursor {
+				marker = "(●)"
+				style = lipgloss.NewStyle().Foreground(m.theme.Primary)
+			}
+			lines = append(lines, style.Render(marker+" "+opt))
+		}
+		return strings.Join(lines, "\n")
+
+	case FieldMultiSelect:
+		var lines []string
+		for i, opt := range f.Options {
+			marker := "[ ]"
+			if f.selected[i] {
+				marker = "[✓]"
+			}
+			style := lipgloss.NewStyle().Foreground(m.theme.Muted)
+			if focused && i == f.cursor {
+				style = lipgloss.NewStyle().Foreground(m.theme.Primary)
+			}
+			lines = append(lines, style.Render(marker+" "+opt))
+		}
+		return strings.Join(lines, "\n")
+
+	default: // FieldText, FieldPassword, FieldNumber
+		return f.input.View()
+	}
+}
@@ -12,38 +12,13 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-)
 
-// =============================================================================
-// THEME (inline for scaffold - use your theme package in real code)
-// =============================================================================
-
-var (
-	colorGold      = lipgloss.Color("#FFD700")
-	colorBlack     = lipgloss.Color("#0A0A0A")
-	colorBlackLight = lipgloss.Color("#1A1A1A")
-	colorWhite     = lipgloss.Color("#FAFAFA")
-	colorGray      = lipgloss.Color("#6B6B6B")
-	colorGrayDark  = lipgloss.Color("#3D3D3D")
-	colorLime      = lipgloss.Color("#39FF14")
-	colorRed       = lipgloss.Color("#FF073A")
-	colorCyan      = lipgloss.Color("#00D9FF")
+	"github.com/MateoSegura/.claude/theme"
 )
 
-// =============================================================================
-// ICONS
-// =============================================================================
-
-const (
-	iconDiamond   = "◆"
-	iconTriangleR = "▸"
-	iconCheck     = "✓"
-	iconCross     = "✗"
-	iconCircle    = "●"
-	iconCircleO   = "○"
-	iconSparkle   = "✦"
-	iconBullet    = "•"
-)
+// Icons come from the active theme.Theme (m.theme.Icons), so a
+// ~/.claude/theme.yaml can restyle them without a recompile. See
+// theme.LoadTheme and theme.DefaultPath.
 
 // =============================================================================
 // ITEM TYPE (customize this for your domain)
@@ -118,20 +93,28 @@ var listKeys = listKeyMap{
 
 // ListScreenModel is a K9s-style list screen.
 type ListScreenModel struct {
-	items    []Item
-	cursor   int
-	width    int
-	height   int
-	title    string
+	items  []Item
+	cursor int
+	width  int
+	height int
+	title  string
+	theme  *theme.Theme
 }
 
-// NewListScreen creates a new list screen.
+// NewListScreen creates a new list screen, styled with theme.Default() until
+// SetTheme says otherwise.
 func NewListScreen(title string) ListScreenModel {
 	return ListScreenModel{
 		title: title,
+		theme: theme.Default(),
 	}
 }
 
+// SetTheme replaces the palette the screen renders with.
+func (m *ListScreenModel) SetTheme(t *theme.Theme) {
+	m.theme = t
+}
+
 // SetItems updates the items list.
 func (m *ListScreenModel) SetItems(items []Item) {
 	m.items = items
@@ -230,12 +213,12 @@ func (m ListScreenModel) View() string {
 
 func (m ListScreenModel) renderHeader() string {
 	left := lipgloss.NewStyle().
-		Foreground(colorGold).
+		Foreground(m.theme.Primary).
 		Bold(true).
-		Render(iconDiamond + " " + m.title)
+		Render(m.theme.Icons.Diamond + " " + m.title)
 
 	right := lipgloss.NewStyle().
-		Foreground(colorGrayDark).
+		Foreground(m.theme.Muted).
 		Render(fmt.Sprintf("Items [%d]", len(m.items)))
 
 	gap := m.width - lipgloss.Width(left) - lipgloss.Width(right) - 2
@@ -243,10 +226,8 @@ func (m ListScreenModel) renderHeader() string {
 		gap = 1
 	}
 
-	return lipgloss.NewStyle().
-		Background(colorBlackLight).
+	return m.theme.HeaderStyle().
 		Width(m.width).
-		Padding(0, 1).
 		Render(left + strings.Repeat(" ", gap) + right)
 }
 
@@ -255,48 +236,46 @@ func (m ListScreenModel) renderFooter() string {
 
 	if len(m.items) > 0 {
 		shortcuts = []string{
-			renderShortcut("↑↓", "Navigate"),
-			renderShortcut("Enter", "Select"),
-			renderShortcut("n", "New"),
-			renderShortcut("d", "Delete"),
-			renderShortcut("q", "Quit"),
+			m.renderShortcut("↑↓", "Navigate"),
+			m.renderShortcut("Enter", "Select"),
+			m.renderShortcut("n", "New"),
+			m.renderShortcut("d", "Delete"),
+			m.renderShortcut("q", "Quit"),
 		}
 	} else {
 		shortcuts = []string{
-			renderShortcut("n", "New"),
-			renderShortcut("q", "Quit"),
+			m.renderShortcut("n", "New"),
+			m.renderShortcut("q", "Quit"),
 		}
 	}
 
-	return lipgloss.NewStyle().
-		Background(colorBlackLight).
+	return m.theme.FooterStyle().
 		Width(m.width).
-		Padding(0, 1).
 		Render(strings.Join(shortcuts, "  "))
 }
 
-func renderShortcut(key, desc string) string {
-	k := lipgloss.NewStyle().Foreground(colorGold).Bold(true).Render("<" + key + ">")
-	d := lipgloss.NewStyle().Foreground(colorGray).Render(desc)
+func (m ListScreenModel) renderShortcut(key, desc string) string {
+	k := m.theme.ShortcutKeyStyle().Render("<" + key + ">")
+	d := lipgloss.NewStyle().Foreground(m.theme.Muted).Render(desc)
 	return k + d
 }
 
 func (m ListScreenModel) renderEmpty() string {
 	icon := lipgloss.NewStyle().
-		Foreground(colorGold).
+		Foreground(m.theme.Primary).
 		Bold(true).
-		Render(iconSparkle + " No Items Yet")
+		Render(m.theme.Icons.Sparkle + " No Items Yet")
 
 	msg := lipgloss.NewStyle().
-		Foreground(colorGray).
+		Foreground(m.theme.Muted).
 		Render("Create your first item to get started.")
 
 	cta := lipgloss.NewStyle().
-		Foreground(colorBlack).
-		Background(colorGold).
+		Foreground(m.theme.Background).
+		Background(m.theme.Primary).
 		Bold(true).
 		Padding(0, 2).
-		Render(iconTriangleR + " Press N to create an item")
+		Render(m.theme.Icons.TriangleR + " Press N to create an item")
 
 	content := lipgloss.JoinVertical(lipgloss.Center, icon, "", msg, "", "", cta)
 
@@ -319,18 +298,18 @@ func (m ListScreenModel) renderList() string {
 
 func (m ListScreenModel) renderItem(item Item, selected bool) string {
 	// State icon and color
-	stateIcon, stateColor := getStateIconColor(item.State)
+	stateIcon, stateColor := m.getStateIconColor(item.State)
 	icon := lipgloss.NewStyle().Foreground(stateColor).Render(stateIcon)
 
 	// Selector
 	var selector string
 	var nameStyle lipgloss.Style
 	if selected {
-		selector = lipgloss.NewStyle().Foreground(colorGold).Render(iconTriangleR + " ")
-		nameStyle = lipgloss.NewStyle().Foreground(colorGold).Bold(true)
+		selector = lipgloss.NewStyle().Foreground(m.theme.Primary).Render(m.theme.Icons.TriangleR + " ")
+		nameStyle = lipgloss.NewStyle().Foreground(m.theme.Primary).Bold(true)
 	} else {
 		selector = "  "
-		nameStyle = lipgloss.NewStyle().Foreground(colorWhite)
+		nameStyle = lipgloss.NewStyle()
 	}
 
 	// Name
@@ -338,13 +317,13 @@ func (m ListScreenModel) renderItem(item Item, selected bool) string {
 
 	// Path (truncated)
 	path := lipgloss.NewStyle().
-		Foreground(colorGray).
+		Foreground(m.theme.Muted).
 		Width(35).
 		Render(truncatePath(item.Path, 35))
 
 	// Time
 	timeStr := lipgloss.NewStyle().
-		Foreground(colorGrayDark).
+		Foreground(m.theme.Muted).
 		Render(formatRelativeTime(item.UpdatedAt))
 
 	// Line 1
@@ -353,20 +332,21 @@ func (m ListScreenModel) renderItem(item Item, selected bool) string {
 	return line1 + "\n"
 }
 
-func getStateIconColor(state string) (string, lipgloss.Color) {
+func (m ListScreenModel) getStateIconColor(state string) (string, lipgloss.Color) {
+	icons := m.theme.Icons
 	switch state {
 	case "running":
-		return iconCircle, colorLime
+		return icons.Circle, m.theme.Running
 	case "success", "completed":
-		return iconCheck, colorGold
+		return icons.Check, m.theme.Success
 	case "failed", "error":
-		return iconCross, colorRed
+		return icons.Cross, m.theme.Error
 	case "pending":
-		return iconCircleO, colorGray
+		return icons.CircleO, m.theme.Pending
 	case "new":
-		return iconSparkle, colorCyan
+		return icons.Sparkle, m.theme.Accent
 	default:
-		return iconCircleO, colorGray
+		return icons.CircleO, m.theme.Pending
 	}
 }
 
@@ -0,0 +1,366 @@
+// Package main - Minimal MCP (Model Context Protocol) Server Scaffold
+//
+// USAGE: Copy this file, rename the package/module import path, and
+// register your own tools/resources/prompts in main. Implements the
+// stdio transport: one JSON-RPC 2.0 message per line on stdin, one per
+// line on stdout. Nothing but JSON-RPC messages may ever reach stdout -
+// anything you want to observe while debugging goes to stderr via
+// Server's own logger instead.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+)
+
+// === JSON-RPC 2.0 ENVELOPE ===
+
+// Request is one incoming JSON-RPC 2.0 call. ID is omitted (nil) for a
+// notification, which dispatch still handles but Run never replies to.
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is one outgoing JSON-RPC 2.0 reply. Exactly one of Result/Error
+// is set, never both.
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+// RPCError is a JSON-RPC 2.0 error object. The codes below are the
+// standard JSON-RPC reserved range; tool failures are NOT reported this
+// way - see ToolHandler's doc comment.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+const (
+	ParseError     = -32700
+	InvalidRequest = -32600
+	MethodNotFound = -32601
+	InvalidParams  = -32602
+	InternalError  = -32603
+)
+
+const protocolVersion = "2024-11-05"
+
+// === TOOLS, RESOURCES, PROMPTS ===
+
+// ToolHandler implements a registered tool's behavior. args is the raw
+// "arguments" object from a tools/call request. A non-nil error becomes a
+// tools/call result with isError:true rather than a JSON-RPC error, so
+// the model sees the failure as part of the conversation instead of the
+// transport retrying the call.
+type ToolHandler func(args json.RawMessage) (string, error)
+
+// Tool is a single registered tool: its JSON Schema (validated by the
+// caller, not by this server) plus the handler RunTool dispatches to.
+type Tool struct {
+	Name        string
+	Description string
+	InputSchema json.RawMessage
+	Handler     ToolHandler
+}
+
+// Resource is a single registered resource, read on demand rather than
+// pushed - Handler is only called when a client sends resources/read.
+type Resource struct {
+	URI         string
+	Name        string
+	Description string
+	MIMEType    string
+	Handler     func() (string, error)
+}
+
+// Prompt is a named prompt template a client can list (via prompts/list)
+// and ask a human to fill in.
+type Prompt struct {
+	Name        string
+	Description string
+}
+
+// === SERVER ===
+
+// Server dispatches JSON-RPC 2.0 requests read from stdin to registered
+// tools/resources/prompts, writing responses to stdout. All non-protocol
+// output (startup, errors, tool failures) goes to log, which defaults to
+// stderr - the stdio transport breaks the instant anything else reaches
+// stdout.
+type Server struct {
+	Name    string
+	Version string
+
+	tools     map[string]Tool
+	resources map[string]Resource
+	prompts   map[string]Prompt
+
+	log *log.Logger
+}
+
+// NewServer creates a Server with no tools/resources/prompts registered.
+func NewServer(name, version string) *Server {
+	return &Server{
+		Name:      name,
+		Version:   version,
+		tools:     make(map[string]Tool),
+		resources: make(map[string]Resource),
+		prompts:   make(map[string]Prompt),
+		log:       log.New(os.Stderr, fmt.Sprintf("[%s] ", name), log.LstdFlags),
+	}
+}
+
+// RegisterTool adds a tool under name, replacing any previous registration
+// with the same name. schema is the tool's input JSON Schema, advertised
+// verbatim by tools/list.
+func (s *Server) RegisterTool(name, description string, schema json.RawMessage, handler ToolHandler) {
+	s.tools[name] = Tool{Name: name, Description: description, InputSchema: schema, Handler: handler}
+}
+
+// RegisterResource adds r, keyed by its URI.
+func (s *Server) RegisterResource(r Resource) {
+	s.resources[r.URI] = r
+}
+
+// RegisterPrompt adds p, keyed by its Name.
+func (s *Server) RegisterPrompt(p Prompt) {
+	s.prompts[p.Name] = p
+}
+
+// Run reads newline-delimited JSON-RPC requests from r and writes
+// newline-delimited responses to w until r reaches EOF, which is treated
+// as a graceful shutdown request (the client closed stdin) rather than an
+// error.
+func (s *Server) Run(r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var req Request
+		if err := json.Unmarshal(line, &req); err != nil {
+			s.writeResponse(w, Response{Error: &RPCError{Code: ParseError, Message: err.Error()}})
+			continue
+		}
+
+		if resp := s.dispatch(req); resp != nil && len(req.ID) > 0 {
+			s.writeResponse(w, *resp)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading stdin: %w", err)
+	}
+	s.log.Println("stdin closed, shutting down")
+	return nil
+}
+
+func (s *Server) writeResponse(w io.Writer, resp Response) {
+	resp.JSONRPC = "2.0"
+	body, err := json.Marshal(resp)
+	if err != nil {
+		s.log.Printf("marshaling response: %v", err)
+		return
+	}
+	if _, err := w.Write(append(body, '\n')); err != nil {
+		s.log.Printf("writing response: %v", err)
+	}
+}
+
+func (s *Server) dispatch(req Request) *Response {
+	switch req.Method {
+	case "initialize":
+		return s.handleInitialize(req)
+	case "tools/list":
+		return s.handleToolsList(req)
+	case "tools/call":
+		return s.handleToolsCall(req)
+	case "resources/list":
+		return s.handleResourcesList(req)
+	case "resources/read":
+		return s.handleResourcesRead(req)
+	case "prompts/list":
+		return s.handlePromptsList(req)
+	default:
+		return &Response{ID: req.ID, Error: &RPCError{Code: MethodNotFound, Message: fmt.Sprintf("unknown method %q", req.Method)}}
+	}
+}
+
+// === METHOD HANDLERS ===
+
+type initializeResult struct {
+	ProtocolVersion string                 `json:"protocolVersion"`
+	Capabilities    map[string]interface{} `json:"capabilities"`
+	ServerInfo      serverInfo             `json:"serverInfo"`
+}
+
+type serverInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+func (s *Server) handleInitialize(req Request) *Response {
+	result := initializeResult{
+		ProtocolVersion: protocolVersion,
+		Capabilities: map[string]interface{}{
+			"tools":     map[string]interface{}{},
+			"resources": map[string]interface{}{},
+			"prompts":   map[string]interface{}{},
+		},
+		ServerInfo: serverInfo{Name: s.Name, Version: s.Version},
+	}
+	return &Response{ID: req.ID, Result: result}
+}
+
+type toolDescriptor struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	InputSchema json.RawMessage `json:"inputSchema"`
+}
+
+func (s *Server) handleToolsList(req Request) *Response {
+	list := make([]toolDescriptor, 0, len(s.tools))
+	for _, t := range s.tools {
+		list = append(list, toolDescriptor{Name: t.Name, Description: t.Description, InputSchema: t.InputSchema})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return &Response{ID: req.ID, Result: map[string]interface{}{"tools": list}}
+}
+
+type toolCallParams struct {
+	Name      string          `json:"name"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+type toolContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type toolCallResult struct {
+	Content []toolContent `json:"content"`
+	IsError bool          `json:"isError,omitempty"`
+}
+
+func (s *Server) handleToolsCall(req Request) *Response {
+	var params toolCallParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return &Response{ID: req.ID, Error: &RPCError{Code: InvalidParams, Message: err.Error()}}
+	}
+
+	tool, ok := s.tools[params.Name]
+	if !ok {
+		return &Response{ID: req.ID, Error: &RPCError{Code: InvalidParams, Message: fmt.Sprintf("unknown tool %q", params.Name)}}
+	}
+
+	text, err := tool.Handler(params.Arguments)
+	if err != nil {
+		s.log.Printf("tool %s failed: %v", params.Name, err)
+		return &Response{ID: req.ID, Result: toolCallResult{Content: []toolContent{{Type: "text", Text: err.Error()}}, IsError: true}}
+	}
+	return &Response{ID: req.ID, Result: toolCallResult{Content: []toolContent{{Type: "text", Text: text}}}}
+}
+
+type resourceDescriptor struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MIMEType    string `json:"mimeType,omitempty"`
+}
+
+func (s *Server) handleResourcesList(req Request) *Response {
+	list := make([]resourceDescriptor, 0, len(s.resources))
+	for _, r := range s.resources {
+		list = append(list, resourceDescriptor{URI: r.URI, Name: r.Name, Description: r.Description, MIMEType: r.MIMEType})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].URI < list[j].URI })
+	return &Response{ID: req.ID, Result: map[string]interface{}{"resources": list}}
+}
+
+type resourceReadParams struct {
+	URI string `json:"uri"`
+}
+
+type resourceContent struct {
+	URI      string `json:"uri"`
+	MIMEType string `json:"mimeType,omitempty"`
+	Text     string `json:"text"`
+}
+
+func (s *Server) handleResourcesRead(req Request) *Response {
+	var params resourceReadParams
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return &Response{ID: req.ID, Error: &RPCError{Code: InvalidParams, Message: err.Error()}}
+	}
+
+	resource, ok := s.resources[params.URI]
+	if !ok {
+		return &Response{ID: req.ID, Error: &RPCError{Code: InvalidParams, Message: fmt.Sprintf("unknown resource %q", params.URI)}}
+	}
+
+	text, err := resource.Handler()
+	if err != nil {
+		return &Response{ID: req.ID, Error: &RPCError{Code: InternalError, Message: err.Error()}}
+	}
+	return &Response{ID: req.ID, Result: map[string]interface{}{
+		"contents": []resourceContent{{URI: resource.URI, MIMEType: resource.MIMEType, Text: text}},
+	}}
+}
+
+type promptDescriptor struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+func (s *Server) handlePromptsList(req Request) *Response {
+	list := make([]promptDescriptor, 0, len(s.prompts))
+	for _, p := range s.prompts {
+		list = append(list, promptDescriptor{Name: p.Name, Description: p.Description})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return &Response{ID: req.ID, Result: map[string]interface{}{"prompts": list}}
+}
+
+// === MAIN ===
+
+func main() {
+	server := NewServer("example-server", "0.1.0")
+
+	server.RegisterTool(
+		"echo",
+		"Echoes back its single \"text\" argument.",
+		json.RawMessage(`{
+			"type": "object",
+			"properties": {"text": {"type": "string"}},
+			"required": ["text"]
+		}`),
+		func(args json.RawMessage) (string, error) {
+			var parsed struct {
+				Text string `json:"text"`
+			}
+			if err := json.Unmarshal(args, &parsed); err != nil {
+				return "", fmt.Errorf("parsing arguments: %w", err)
+			}
+			return parsed.Text, nil
+		},
+	)
+
+	if err := server.Run(os.Stdin, os.Stdout); err != nil {
+		server.log.Fatalf("server error: %v", err)
+	}
+}
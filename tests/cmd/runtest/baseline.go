@@ -0,0 +1,201 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+)
+
+// caseSnapshot is one case's outcome from a canonical run, keyed by case
+// name within a BaselineSnapshot.
+type caseSnapshot struct {
+	Passed     bool    `json:"passed"`
+	Score      float64 `json:"score"`
+	Iterations int     `json:"iterations"`
+	PassRate   float64 `json:"pass_rate"` // fraction of Iterations that passed
+}
+
+// BaselineSnapshot is the canonical recorded state of a suite, written to
+// .claude/baselines/<suite>.json by -baseline-update.
+type BaselineSnapshot struct {
+	Suite string                  `json:"suite"`
+	Cases map[string]caseSnapshot `json:"cases"`
+}
+
+// BaselineDiffKind classifies how a case's current result compares to its
+// recorded BaselineSnapshot entry.
+type BaselineDiffKind string
+
+const (
+	DiffUnchanged    BaselineDiffKind = "unchanged"
+	DiffNewlyFailing BaselineDiffKind = "newly-failing"
+	DiffNewlyPassing BaselineDiffKind = "newly-passing"
+	DiffNewCase      BaselineDiffKind = "new-case"
+	DiffRemovedCase  BaselineDiffKind = "removed-case"
+	DiffFlaky        BaselineDiffKind = "flaky"
+)
+
+// BaselineCaseDiff is one case's classification against the baseline.
+type BaselineCaseDiff struct {
+	Case    string           `json:"case"`
+	Kind    BaselineDiffKind `json:"kind"`
+	Message string           `json:"message"`
+}
+
+// BaselineDiff is the full comparison of a run against its baseline.
+type BaselineDiff struct {
+	Suite        string             `json:"suite"`
+	Cases        []BaselineCaseDiff `json:"cases"`
+	NewlyFailing []BaselineCaseDiff `json:"-"`
+}
+
+// baselinePath returns the default snapshot path for suiteName, mirroring
+// the repo-root-relative .claude layout the other extensions use.
+func baselinePath(workDir, suiteName string) string {
+	return filepath.Join(workDir, ".claude", "baselines", fmt.Sprintf("%s.json", suiteName))
+}
+
+// loadBaselineSnapshot reads path, tolerating a missing file by returning an
+// empty snapshot - a suite with no baseline yet just gets every case
+// reported as new-case until -baseline-update records one.
+func loadBaselineSnapshot(suiteName, path string) (*BaselineSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &BaselineSnapshot{Suite: suiteName, Cases: map[string]caseSnapshot{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading baseline %s: %w", path, err)
+	}
+	var snap BaselineSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("parsing baseline %s: %w", path, err)
+	}
+	if snap.Cases == nil {
+		snap.Cases = map[string]caseSnapshot{}
+	}
+	return &snap, nil
+}
+
+// saveBaselineSnapshot writes snap to path as indented JSON, creating its
+// parent directory if needed.
+func saveBaselineSnapshot(snap *BaselineSnapshot, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating baseline dir: %w", err)
+	}
+	data, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// snapshotFromResult builds the BaselineSnapshot -baseline-update would
+// record for result, grouping its (possibly multiple) iterations per case.
+func snapshotFromResult(result *skilltests.SuiteResult) *BaselineSnapshot {
+	byCase := map[string][]*skilltests.TestResult{}
+	var order []string
+	for _, r := range result.Results {
+		if _, seen := byCase[r.Name]; !seen {
+			order = append(order, r.Name)
+		}
+		byCase[r.Name] = append(byCase[r.Name], r)
+	}
+
+	snap := &BaselineSnapshot{Suite: result.Name, Cases: map[string]caseSnapshot{}}
+	for _, name := range order {
+		runs := byCase[name]
+		passed := 0
+		totalScore := 0.0
+		for _, r := range runs {
+			if r.Passed {
+				passed++
+			}
+			totalScore += r.Score
+		}
+		snap.Cases[name] = caseSnapshot{
+			Passed:     passed == len(runs),
+			Score:      totalScore / float64(len(runs)),
+			Iterations: len(runs),
+			PassRate:   float64(passed) / float64(len(runs)),
+		}
+	}
+	return snap
+}
+
+// compareToBaseline classifies every case in result against the snapshot at
+// baselinePath, plus every baseline case result no longer contains. A case
+// with Iterations>1 that fell from a consistent pass rate to a noisier one
+// is flagged flaky rather than newly-failing, since a single bad iteration
+// isn't the same signal as the whole case regressing.
+func compareToBaseline(result *skilltests.SuiteResult, path string) (*BaselineDiff, error) {
+	baseline, err := loadBaselineSnapshot(result.Name, path)
+	if err != nil {
+		return nil, err
+	}
+
+	current := snapshotFromResult(result)
+	diff := &BaselineDiff{Suite: result.Name}
+
+	seen := map[string]bool{}
+	for name, cur := range current.Cases {
+		seen[name] = true
+		prior, hadBaseline := baseline.Cases[name]
+
+		var cd BaselineCaseDiff
+		cd.Case = name
+
+		switch {
+		case !hadBaseline:
+			cd.Kind = DiffNewCase
+			cd.Message = "no prior baseline entry"
+		case cur.Iterations > 1 && prior.PassRate == 1.0 && cur.PassRate > 0 && cur.PassRate < 1.0:
+			cd.Kind = DiffFlaky
+			cd.Message = fmt.Sprintf("passed %.0f%% of %d iterations after a consistent baseline pass", cur.PassRate*100, cur.Iterations)
+		case prior.Passed && !cur.Passed:
+			cd.Kind = DiffNewlyFailing
+			cd.Message = fmt.Sprintf("baseline passed (score %.2f), now failing (score %.2f)", prior.Score, cur.Score)
+		case !prior.Passed && cur.Passed:
+			cd.Kind = DiffNewlyPassing
+			cd.Message = fmt.Sprintf("baseline failed (score %.2f), now passing (score %.2f)", prior.Score, cur.Score)
+		default:
+			cd.Kind = DiffUnchanged
+			cd.Message = fmt.Sprintf("score %.2f, consistent with baseline", cur.Score)
+		}
+
+		diff.Cases = append(diff.Cases, cd)
+		if cd.Kind == DiffNewlyFailing {
+			diff.NewlyFailing = append(diff.NewlyFailing, cd)
+		}
+	}
+
+	for name := range baseline.Cases {
+		if !seen[name] {
+			diff.Cases = append(diff.Cases, BaselineCaseDiff{
+				Case:    name,
+				Kind:    DiffRemovedCase,
+				Message: "present in baseline, no longer in suite",
+			})
+		}
+	}
+
+	return diff, nil
+}
+
+// printBaselineDiff prints one line per case diff that isn't plain
+// "unchanged", so a clean run produces no baseline noise at all.
+func printBaselineDiff(diff *BaselineDiff) {
+	interesting := false
+	for _, cd := range diff.Cases {
+		if cd.Kind == DiffUnchanged {
+			continue
+		}
+		if !interesting {
+			fmt.Printf("\nBaseline comparison for %s:\n", diff.Suite)
+			interesting = true
+		}
+		fmt.Printf("  [%s] %s: %s\n", cd.Kind, cd.Case, cd.Message)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestBaselinePath(t *testing.T) {
+	got := baselinePath("/repo", "bubbletea-tui")
+	want := filepath.Join("/repo", ".claude", "baselines", "bubbletea-tui.json")
+	if got != want {
+		t.Errorf("baselinePath() = %q, want %q", got, want)
+	}
+}
+
+func TestLoadBaselineSnapshotMissingFile(t *testing.T) {
+	snap, err := loadBaselineSnapshot("my-suite", filepath.Join(t.TempDir(), "does-not-exist.json"))
+	if err != nil {
+		t.Fatalf("loadBaselineSnapshot on a missing file returned an error: %v", err)
+	}
+	if snap.Suite != "my-suite" {
+		t.Errorf("snap.Suite = %q, want %q", snap.Suite, "my-suite")
+	}
+	if snap.Cases == nil || len(snap.Cases) != 0 {
+		t.Errorf("snap.Cases = %v, want a non-nil empty map", snap.Cases)
+	}
+}
+
+func TestSaveAndLoadBaselineSnapshotRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baselines", "my-suite.json")
+
+	original := &BaselineSnapshot{
+		Suite: "my-suite",
+		Cases: map[string]caseSnapshot{
+			"basic-case": {Passed: true, Score: 0.95, Iterations: 3, PassRate: 1.0},
+		},
+	}
+	if err := saveBaselineSnapshot(original, path); err != nil {
+		t.Fatalf("saveBaselineSnapshot: %v", err)
+	}
+
+	loaded, err := loadBaselineSnapshot("my-suite", path)
+	if err != nil {
+		t.Fatalf("loadBaselineSnapshot: %v", err)
+	}
+
+	got, ok := loaded.Cases["basic-case"]
+	if !ok {
+		t.Fatal("loaded snapshot is missing case \"basic-case\"")
+	}
+	if got != original.Cases["basic-case"] {
+		t.Errorf("loaded case = %+v, want %+v", got, original.Cases["basic-case"])
+	}
+}
@@ -12,11 +12,11 @@ import (
 	"strings"
 	"time"
 
-	"github.com/MateoSegura/.claude/tests"
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
 )
 
 func main() {
-	runner := tests.NewTestRunner()
+	runner := skilltests.NewTestRunner()
 	if runner.DryRun {
 		fmt.Println("Warning: Running in dry-run mode (Claude CLI not available)")
 		os.Exit(1)
@@ -33,84 +33,83 @@ func main() {
 	}
 	runner.WorkDir = workDir
 
-	suite := &tests.Suite{
-		Name:          "commands-full",
-		ExtensionType: tests.ExtensionCommand,
-		Cases: []*tests.TestCase{
+	suite := &skilltests.Suite{
+		Name: "commands-full",
+		Cases: []*skilltests.TestCase{
 			// /new-skill
 			{
 				Name:          "new-skill-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-skill",
 				Prompt:        "Show me how to create a skill called 'language-rust-embedded'. What's the directory structure and SKILL.md template?",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-structure", "Response shows skill directory structure with SKILL.md and explains frontmatter format"),
-					tests.ContainsText("SKILL.md"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(runner.Judge, "shows-structure", "Response shows skill directory structure with SKILL.md and explains frontmatter format"),
+					skilltests.ContainsText("SKILL.md"),
 				},
 			},
 			// /new-rule
 			{
 				Name:          "new-rule-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-rule",
 				Prompt:        "Create a rule to prevent console.log in production code. Show me the template.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-rule-format", "Response shows rule markdown format with good/bad examples section"),
-					tests.MatchesRegex(`rules/|\.md`),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(runner.Judge, "shows-rule-format", "Response shows rule markdown format with good/bad examples section"),
+					skilltests.MatchesRegex(`rules/|\.md`),
 				},
 			},
 			// /new-command
 			{
 				Name:          "new-command-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-command",
 				Prompt:        "Show me the template for creating a /deploy command.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-command-format", "Response shows command markdown with frontmatter description field"),
-					tests.ContainsText("description"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(runner.Judge, "shows-command-format", "Response shows command markdown with frontmatter description field"),
+					skilltests.ContainsText("description"),
 				},
 			},
 			// /new-agent
 			{
 				Name:          "new-agent-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-agent",
 				Prompt:        "Create a code review agent. What template and tools should I use?",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-agent-format", "Response shows agent template with tools and model fields in frontmatter"),
-					tests.MatchesRegex(`tools:|Read|Grep`),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(runner.Judge, "shows-agent-format", "Response shows agent template with tools and model fields in frontmatter"),
+					skilltests.MatchesRegex(`tools:|Read|Grep`),
 				},
 			},
 			// /new-hook
 			{
 				Name:          "new-hook-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-hook",
 				Prompt:        "Create a hook to run prettier after writing JavaScript files.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-hook-json", "Response shows JSON hook config with PostToolUse event and matcher for JS files"),
-					tests.ContainsText("PostToolUse"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(runner.Judge, "shows-hook-json", "Response shows JSON hook config with PostToolUse event and matcher for JS files"),
+					skilltests.ContainsText("PostToolUse"),
 				},
 			},
 			// /list-extensions
 			{
 				Name:          "list-extensions-all",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "list-extensions",
 				Prompt:        "List all extensions in this knowledge base.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("lists-extensions", "Response lists extensions by type with meta-skill-create and meta-skill-update mentioned"),
-					tests.MatchesRegex(`meta-skill|Commands|Skills`),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(runner.Judge, "lists-extensions", "Response lists extensions by type with meta-skill-create and meta-skill-update mentioned"),
+					skilltests.MatchesRegex(`meta-skill|Commands|Skills`),
 				},
 			},
 			// /update-extension
 			{
 				Name:          "update-extension-workflow",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "update-extension",
 				Prompt:        "Explain the process for updating an existing skill when a library releases a new version.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("explains-workflow", "Response explains update workflow: analyze current state, research changes, update patterns, test"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(runner.Judge, "explains-workflow", "Response explains update workflow: analyze current state, research changes, update patterns, test"),
 				},
 			},
 		},
@@ -132,7 +131,7 @@ func main() {
 	fmt.Println(strings.Repeat("=", 60))
 	fmt.Printf("Suite: %s\n", result.Name)
 	fmt.Printf("Tests: %d total, %d passed, %d failed\n", result.TotalTests, result.Passed, result.Failed)
-	fmt.Printf("Score: %.0f%% (Grade: %s)\n", result.Score*100, tests.DefaultGradeScale().Grade(result.Score))
+	fmt.Printf("Score: %.0f%% (Grade: %s)\n", result.Score*100, skilltests.DefaultGradeScale().Grade(result.Score))
 	fmt.Printf("Duration: %v\n", result.Duration)
 	fmt.Println(strings.Repeat("=", 60))
 
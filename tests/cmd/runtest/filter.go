@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+)
+
+// Filter selects which suites/cases -run lets through. A nil RE on either
+// side matches anything, so the zero value matches everything.
+type Filter struct {
+	SuiteRE *regexp.Regexp
+	CaseRE  *regexp.Regexp
+}
+
+// parseFilter parses -run's argument, gator's test runner two-axis syntax:
+// "suite//" matches by suite name, "//case" matches by case name,
+// "suite//case" anchors both (either side empty means "match anything"
+// on that axis), and a bare pattern with no "//" matches either a suite's
+// or a case's name.
+func parseFilter(pattern string) (Filter, error) {
+	if pattern == "" {
+		return Filter{}, nil
+	}
+
+	if !strings.Contains(pattern, "//") {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return Filter{}, fmt.Errorf("-run %q: %w", pattern, err)
+		}
+		return Filter{SuiteRE: re, CaseRE: re}, nil
+	}
+
+	parts := strings.SplitN(pattern, "//", 2)
+	var f Filter
+	if parts[0] != "" {
+		re, err := regexp.Compile(parts[0])
+		if err != nil {
+			return Filter{}, fmt.Errorf("-run suite pattern %q: %w", parts[0], err)
+		}
+		f.SuiteRE = re
+	}
+	if parts[1] != "" {
+		re, err := regexp.Compile(parts[1])
+		if err != nil {
+			return Filter{}, fmt.Errorf("-run case pattern %q: %w", parts[1], err)
+		}
+		f.CaseRE = re
+	}
+	return f, nil
+}
+
+// MatchesSuite reports whether name passes SuiteRE. SuiteRunner.runOne uses
+// it to skip a whole suite before calling RunSuite, except for a bare
+// pattern, where the suite's cases are filtered instead.
+func (f Filter) MatchesSuite(name string) bool {
+	return f.SuiteRE == nil || f.SuiteRE.MatchString(name)
+}
+
+// MatchesCase reports whether name passes CaseRE.
+func (f Filter) MatchesCase(name string) bool {
+	return f.CaseRE == nil || f.CaseRE.MatchString(name)
+}
+
+// FilterCases splits cases into the ones that match f.CaseRE and the names
+// of the ones that don't, so a caller can run the former and report the
+// latter as skipped rather than silently dropping them.
+func (f Filter) FilterCases(cases []*skilltests.TestCase) (kept []*skilltests.TestCase, skipped []string) {
+	for _, c := range cases {
+		if f.MatchesCase(c.Name) {
+			kept = append(kept, c)
+		} else {
+			skipped = append(skipped, c.Name)
+		}
+	}
+	return kept, skipped
+}
+
+// saveSkipped writes the cases -run filtered out of a suite to
+// outputDir/filename as a JSON array, the skipped-case counterpart to
+// TestRunner.SaveSuiteResults.
+func saveSkipped(outputDir, filename string, names []string) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(names, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(outputDir, filename), data, 0644)
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+)
+
+// knownFormats are the -format values saveFormats understands.
+var knownFormats = map[string]bool{"json": true, "junit": true, "tap": true}
+
+// parseFormats splits a comma-separated -format value and validates each
+// entry, so a typo fails fast instead of silently dropping an artifact.
+func parseFormats(s string) ([]string, error) {
+	if s == "" {
+		return []string{"json"}, nil
+	}
+	var formats []string
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		if !knownFormats[f] {
+			return nil, fmt.Errorf("unknown -format %q (want json, junit, or tap)", f)
+		}
+		formats = append(formats, f)
+	}
+	return formats, nil
+}
+
+// junitTestSuites is the root of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     float64         `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string         `xml:"classname,attr"`
+	Name      string         `xml:"name,attr"`
+	Time      float64        `xml:"time,attr"`
+	Failures  []junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// junitReport converts result into a <testsuites><testsuite><testcase> tree,
+// the shape GitHub Actions / GitLab / Jenkins all know how to render.
+// Iterations of the same case become distinct testcases named
+// "<case>-iter-<n>" so a flaky iteration shows up on its own line rather
+// than being folded into its sibling's pass/fail.
+func junitReport(result *skilltests.SuiteResult) junitTestSuites {
+	suite := junitTestSuite{
+		Name:     result.Name,
+		Tests:    result.TotalTests,
+		Failures: result.Failed,
+		Time:     result.Duration.Seconds(),
+	}
+
+	iterSeen := map[string]int{}
+	for _, r := range result.Results {
+		name := r.Name
+		if r.Iteration > 0 {
+			iterSeen[r.Name]++
+			name = fmt.Sprintf("%s-iter-%d", r.Name, iterSeen[r.Name])
+		}
+
+		tc := junitTestCase{
+			ClassName: result.Name,
+			Name:      name,
+			Time:      r.Duration.Seconds(),
+		}
+		for _, v := range r.Validations {
+			if v.Passed {
+				continue
+			}
+			tc.Failures = append(tc.Failures, junitFailure{
+				Message: truncateMessage(v.Message, 200),
+				Type:    v.Name,
+				Text:    v.Message,
+			})
+		}
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	return junitTestSuites{Suites: []junitTestSuite{suite}}
+}
+
+// saveJUnit writes result as JUnit XML to path.
+func saveJUnit(result *skilltests.SuiteResult, path string) error {
+	data, err := xml.MarshalIndent(junitReport(result), "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append([]byte(xml.Header), data...)
+	return os.WriteFile(path, data, 0644)
+}
+
+// tapReport renders result as TAP v13: "ok N - name" / "not ok N - name",
+// with a YAML block under each failing case carrying its validator
+// messages, the same detail JUnit puts in <failure> text.
+func tapReport(result *skilltests.SuiteResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "TAP version 13\n")
+	fmt.Fprintf(&b, "1..%d\n", len(result.Results))
+
+	iterSeen := map[string]int{}
+	for i, r := range result.Results {
+		name := r.Name
+		if r.Iteration > 0 {
+			iterSeen[r.Name]++
+			name = fmt.Sprintf("%s-iter-%d", r.Name, iterSeen[r.Name])
+		}
+
+		status := "ok"
+		if !r.Passed {
+			status = "not ok"
+		}
+		fmt.Fprintf(&b, "%s %d - %s\n", status, i+1, name)
+
+		if r.Passed {
+			continue
+		}
+		fmt.Fprintf(&b, "  ---\n")
+		fmt.Fprintf(&b, "  score: %.2f\n", r.Score)
+		fmt.Fprintf(&b, "  messages:\n")
+		for _, v := range r.Validations {
+			if v.Passed {
+				continue
+			}
+			fmt.Fprintf(&b, "    - %s: %s\n", v.Name, truncateMessage(v.Message, 200))
+		}
+		fmt.Fprintf(&b, "  ...\n")
+	}
+
+	return b.String()
+}
+
+// saveTAP writes result as TAP v13 to path.
+func saveTAP(result *skilltests.SuiteResult, path string) error {
+	return os.WriteFile(path, []byte(tapReport(result)), 0644)
+}
+
+// truncateMessage trims s to maxLen bytes, matching the truncation runtest
+// already applies before printing validator messages to the console.
+func truncateMessage(s string, maxLen int) string {
+	if len(s) <= maxLen {
+		return s
+	}
+	return s[:maxLen-3] + "..."
+}
+
+// saveFormats writes result to outputDir in every requested format,
+// reporting each path it wrote (or the first error it hit).
+func saveFormats(result *skilltests.SuiteResult, outputDir, suiteName string, formats []string) ([]string, error) {
+	var written []string
+	for _, f := range formats {
+		var path string
+		var err error
+		switch f {
+		case "json":
+			path = filepath.Join(outputDir, fmt.Sprintf("%s-results.json", suiteName))
+			// JSON is saved via runner.SaveSuiteResults by the caller, not
+			// here, so it keeps going through TestRunner.OutputDir/FS.
+			continue
+		case "junit":
+			path = filepath.Join(outputDir, fmt.Sprintf("%s-results.xml", suiteName))
+			err = saveJUnit(result, path)
+		case "tap":
+			path = filepath.Join(outputDir, fmt.Sprintf("%s-results.tap", suiteName))
+			err = saveTAP(result, path)
+		}
+		if err != nil {
+			return written, fmt.Errorf("saving %s: %w", f, err)
+		}
+		written = append(written, path)
+	}
+	return written, nil
+}
@@ -1,21 +1,47 @@
 // Command runtest executes extension tests against Claude CLI.
-// Usage: go run ./tests/cmd/runtest [suite]
+// Usage: go run ./tests/cmd/runtest [flags] [suite]
 // Suites: skills (default), commands, all
+// Flags: -run for suite/case filtering, -format=json,junit,tap for
+// which result artifacts to write (json is always on by default),
+// -baseline/-baseline-update for regression detection against
+// .claude/baselines/<suite>.json (exits 2 on a newly-failing case), and
+// -concurrency/-rate-limit to bound parallel case execution.
 package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
-	"github.com/MateoSegura/.claude/tests"
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
 )
 
 func main() {
-	runner := tests.NewTestRunner()
+	runPattern := flag.String("run", "", "Filter suites/cases to run, gator-style: \"suite//\" by suite name, \"//case\" by case name, \"suite//case\" both, or a bare pattern matching either")
+	formatFlag := flag.String("format", "json", "Comma-separated result formats to write: json, junit, tap")
+	baselineFlag := flag.Bool("baseline", false, "Compare results against .claude/baselines/<suite>.json and exit 2 on any newly-failing case")
+	baselineUpdate := flag.Bool("baseline-update", false, "Record this run's results as the new baseline snapshot")
+	concurrency := flag.Int("concurrency", 1, "Max cases (and iterations of the same case) to run at once")
+	rateLimit := flag.Int("rate-limit", 0, "Cap on Claude invocations per minute across the whole run (0 = unlimited)")
+	flag.Parse()
+
+	filter, err := parseFilter(*runPattern)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	formats, err := parseFormats(*formatFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	runner := skilltests.NewTestRunner()
 	if runner.DryRun {
 		fmt.Println("Warning: Running in dry-run mode (Claude CLI not available)")
 		fmt.Println("Results will be simulated, not real.")
@@ -32,42 +58,52 @@ func main() {
 
 	// Determine which suite to run
 	suiteName := "skills"
-	if len(os.Args) > 1 {
-		suiteName = os.Args[1]
+	if flag.NArg() > 0 {
+		suiteName = flag.Arg(0)
 	}
 
-	var suites []*tests.Suite
+	var suites []*skilltests.Suite
 
 	switch suiteName {
 	case "skills":
-		suites = append(suites, skillsSuite())
+		suites = append(suites, skillsSuite(runner.Judge))
 	case "commands":
-		suites = append(suites, commandsSuite())
+		suites = append(suites, commandsSuite(runner.Judge))
 	case "all":
-		suites = append(suites, skillsSuite(), commandsSuite())
+		suites = append(suites, skillsSuite(runner.Judge), commandsSuite(runner.Judge))
 	default:
 		fmt.Printf("Unknown suite: %s\n", suiteName)
 		fmt.Println("Available: skills, commands, all")
 		os.Exit(1)
 	}
 
-	for _, suite := range suites {
-		runSuite(runner, suite)
+	sr := &SuiteRunner{
+		Runner:         runner,
+		Filter:         filter,
+		Formats:        formats,
+		Concurrency:    *concurrency,
+		RateLimit:      *rateLimit,
+		CheckBaseline:  *baselineFlag,
+		UpdateBaseline: *baselineUpdate,
 	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Minute)
+	defer cancel()
+
+	sr.Run(ctx, suites...)
 }
 
-func skillsSuite() *tests.Suite {
-	return &tests.Suite{
-		Name:          "meta-skill-create",
-		ExtensionType: tests.ExtensionSkill,
-		Extension:     "meta-skill-create",
-		Cases: []*tests.TestCase{
+func skillsSuite(judge *skilltests.Judge) *skilltests.Suite {
+	return &skilltests.Suite{
+		Name:  "meta-skill-create",
+		Skill: "meta-skill-create",
+		Cases: []*skilltests.TestCase{
 			{
 				Name:      "recommends-hook-for-formatting",
 				Extension: "meta-skill-create",
 				Prompt:    "I want to automatically run gofmt after Claude writes Go files. What type of Claude Code extension should I create?",
-				Validators: []tests.Validator{
-					tests.LLMValidator(
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge,
 						"identifies-hook",
 						"The response recommends using a HOOK (specifically mentioning PostToolUse or post-tool-use event) for automatically running commands after file writes",
 					),
@@ -77,8 +113,8 @@ func skillsSuite() *tests.Suite {
 				Name:      "recommends-mcp-for-database",
 				Extension: "meta-skill-create",
 				Prompt:    "I want Claude to be able to query my PostgreSQL database directly. What extension type should I use?",
-				Validators: []tests.Validator{
-					tests.LLMValidator(
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge,
 						"identifies-mcp",
 						"The response recommends using an MCP server (Model Context Protocol) for database access",
 					),
@@ -88,8 +124,8 @@ func skillsSuite() *tests.Suite {
 				Name:      "recommends-rule-for-constraint",
 				Extension: "meta-skill-create",
 				Prompt:    "I want Claude to never use the 'any' type when writing TypeScript. What's the simplest extension for this?",
-				Validators: []tests.Validator{
-					tests.LLMValidator(
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge,
 						"identifies-rule",
 						"The response recommends using a RULE (not a skill) for simple always-on constraints",
 					),
@@ -99,100 +135,100 @@ func skillsSuite() *tests.Suite {
 	}
 }
 
-func commandsSuite() *tests.Suite {
-	return &tests.Suite{
-		Name:          "commands",
-		ExtensionType: tests.ExtensionCommand,
-		Cases: []*tests.TestCase{
+func commandsSuite(judge *skilltests.Judge) *skilltests.Suite {
+	return &skilltests.Suite{
+		Name: "commands",
+		Cases: []*skilltests.TestCase{
 			{
 				Name:          "new-skill-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-skill",
 				Prompt:        "Show me how to create a skill called 'language-rust-embedded'. What's the directory structure and SKILL.md template?",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-structure", "Response shows skill directory structure with SKILL.md and explains frontmatter format"),
-					tests.ContainsText("SKILL.md"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge, "shows-structure", "Response shows skill directory structure with SKILL.md and explains frontmatter format"),
+					skilltests.ContainsText("SKILL.md"),
 				},
 			},
 			{
 				Name:          "new-rule-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-rule",
 				Prompt:        "Create a rule to prevent console.log in production code. Show me the template.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-rule-format", "Response shows rule markdown format with good/bad examples section"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge, "shows-rule-format", "Response shows rule markdown format with good/bad examples section"),
 				},
 			},
 			{
 				Name:          "new-command-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-command",
 				Prompt:        "Show me the template for creating a /deploy command.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-command-format", "Response shows command markdown with frontmatter description field"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge, "shows-command-format", "Response shows command markdown with frontmatter description field"),
 				},
 			},
 			{
 				Name:          "new-agent-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-agent",
 				Prompt:        "Show me the markdown template for creating a code review agent. I want to see the frontmatter format with name, description, tools, and model fields.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-agent-format", "Response shows the agent markdown template structure with frontmatter containing tools field"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge, "shows-agent-format", "Response shows the agent markdown template structure with frontmatter containing tools field"),
 				},
 			},
 			{
 				Name:          "new-hook-template",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "new-hook",
 				Prompt:        "Create a hook to run prettier after writing JavaScript files.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("shows-hook-json", "Response shows JSON hook config with PostToolUse event"),
-					tests.ContainsText("PostToolUse"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge, "shows-hook-json", "Response shows JSON hook config with PostToolUse event"),
+					skilltests.ContainsText("PostToolUse"),
 				},
 			},
 			{
 				Name:          "list-extensions-all",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "list-extensions",
 				Prompt:        "List all extensions in this knowledge base.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("lists-extensions", "Response lists extensions by type including skills and commands"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge, "lists-extensions", "Response lists extensions by type including skills and commands"),
 				},
 			},
 			{
 				Name:          "update-extension-workflow",
-				ExtensionType: tests.ExtensionCommand,
+				ExtensionType: "commands",
 				Extension:     "update-extension",
 				Prompt:        "Explain the process for updating an existing skill.",
-				Validators: []tests.Validator{
-					tests.LLMValidator("explains-workflow", "Response explains update workflow with steps like analyze, research, update, test"),
+				Validators: []skilltests.Validator{
+					skilltests.RuleFollowed(judge, "explains-workflow", "Response explains update workflow with steps like analyze, research, update, test"),
 				},
 			},
 		},
 	}
 }
 
-func runSuite(runner *tests.TestRunner, suite *tests.Suite) {
+// printSuiteHeader prints the banner SuiteRunner.runOne shows before
+// invoking a suite, including which cases -run skipped.
+func printSuiteHeader(suite *skilltests.Suite, skipped []string) {
 	fmt.Printf("\n%s\n", strings.Repeat("=", 60))
 	fmt.Printf("Running test suite: %s\n", suite.Name)
-	fmt.Printf("Extension type: %s\n", suite.ExtensionType)
-	fmt.Printf("%s\n\n", strings.Repeat("=", 60))
-
-	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Minute)
-	defer cancel()
-
-	result, err := runner.RunSuite(ctx, suite)
-	if err != nil {
-		fmt.Printf("Suite execution failed: %v\n", err)
-		os.Exit(1)
+	if suite.Skill != "" {
+		fmt.Printf("Skill: %s\n", suite.Skill)
+	}
+	if len(skipped) > 0 {
+		fmt.Printf("Skipped (doesn't match -run): %s\n", strings.Join(skipped, ", "))
 	}
+	fmt.Printf("%s\n\n", strings.Repeat("=", 60))
+}
 
-	// Print results
+// printSuiteResult prints the summary line, per-case pass/fail, and
+// per-validator messages for a finished suite run.
+func printSuiteResult(result *skilltests.SuiteResult, skipped []string) {
 	fmt.Println(strings.Repeat("-", 60))
 	fmt.Printf("Suite: %s\n", result.Name)
-	fmt.Printf("Tests: %d total, %d passed, %d failed\n", result.TotalTests, result.Passed, result.Failed)
-	fmt.Printf("Score: %.0f%% (Grade: %s)\n", result.Score*100, tests.DefaultGradeScale().Grade(result.Score))
+	fmt.Printf("Tests: %d total, %d passed, %d failed, %d skipped\n", result.TotalTests, result.Passed, result.Failed, len(skipped))
+	fmt.Printf("Score: %.0f%% (Grade: %s)\n", result.Score*100, skilltests.DefaultGradeScale().Grade(result.Score))
 	fmt.Printf("Duration: %v\n", result.Duration)
 	fmt.Println(strings.Repeat("-", 60))
 
@@ -215,19 +251,56 @@ func runSuite(runner *tests.TestRunner, suite *tests.Suite) {
 			fmt.Printf("%s %s: %s\n", vStatus, v.Name, msg)
 		}
 	}
+}
 
-	// Save results
-	filename := fmt.Sprintf("%s-results.json", suite.Name)
+// saveSuiteResult writes result in every requested format, plus the
+// sibling skipped-cases file. skilltests.SuiteResult has no notion of a
+// skipped case (it only ever sees the ones -run let through), so the skip
+// list is written as a small sibling file instead of a field on the main
+// report.
+func saveSuiteResult(runner *skilltests.TestRunner, result *skilltests.SuiteResult, suiteName string, skipped []string, formats []string) {
+	filename := fmt.Sprintf("%s-results.json", suiteName)
 	if err := runner.SaveSuiteResults(result, filename); err != nil {
 		fmt.Printf("\nWarning: couldn't save results: %v\n", err)
 	} else {
 		fmt.Printf("\nResults saved to %s/%s\n", runner.OutputDir, filename)
 	}
+	if len(skipped) > 0 {
+		if err := saveSkipped(runner.OutputDir, fmt.Sprintf("%s-skipped.json", suiteName), skipped); err != nil {
+			fmt.Printf("Warning: couldn't save skipped cases: %v\n", err)
+		}
+	}
+	if written, err := saveFormats(result, runner.OutputDir, suiteName, formats); err != nil {
+		fmt.Printf("Warning: couldn't save results: %v\n", err)
+	} else {
+		for _, path := range written {
+			fmt.Printf("Results saved to %s\n", path)
+		}
+	}
+}
 
-	if result.Score < 0.70 {
-		fmt.Printf("\n❌ Suite failed: %.0f%% < 70%% threshold\n", result.Score*100)
-		os.Exit(1)
+// checkSuiteBaseline compares result against its baseline snapshot, prints
+// the diff, optionally records a new snapshot, and exits 2 - a code
+// distinct from the plain below-threshold exit 1 - if checkBaseline is set
+// and any case newly regressed.
+func checkSuiteBaseline(runner *skilltests.TestRunner, result *skilltests.SuiteResult, suiteName string, checkBaseline, updateBaseline bool) {
+	bp := baselinePath(runner.WorkDir, suiteName)
+	diff, err := compareToBaseline(result, bp)
+	if err != nil {
+		fmt.Printf("\nWarning: couldn't compare against baseline: %v\n", err)
+		return
 	}
 
-	fmt.Printf("\n✅ Suite passed!\n")
+	printBaselineDiff(diff)
+	if updateBaseline {
+		if err := saveBaselineSnapshot(snapshotFromResult(result), bp); err != nil {
+			fmt.Printf("Warning: couldn't save baseline: %v\n", err)
+		} else {
+			fmt.Printf("Baseline updated at %s\n", bp)
+		}
+	}
+	if checkBaseline && len(diff.NewlyFailing) > 0 {
+		fmt.Printf("\n❌ Baseline regression: %d case(s) newly failing\n", len(diff.NewlyFailing))
+		os.Exit(2)
+	}
 }
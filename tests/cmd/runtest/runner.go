@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	skilltests "github.com/MateoSegura/.claude/skill-tests"
+)
+
+// SuiteRunner bundles everything runSuite used to do inline - filtering,
+// invoking the suite, printing, saving every -format, and baseline
+// comparison - into one reusable value, so a caller just configures it
+// once and calls Run per suite instead of threading six parameters through
+// a free function.
+//
+// It only wraps this package's own call site. Suites hand-rolled as
+// compiled *_test.go files (the skill-tests/cmd/run-tests convention) are
+// driven by `go test` directly, not by this binary, so they aren't wired
+// through SuiteRunner either.
+type SuiteRunner struct {
+	Runner *skilltests.TestRunner
+
+	Filter  Filter
+	Formats []string
+
+	// Concurrency bounds how many cases (and iterations of the same case)
+	// run at once; it's forwarded to Runner.Parallelism, which already
+	// owns the worker pool. 0 or 1 means sequential, matching Parallelism.
+	Concurrency int
+
+	// RateLimit caps Claude invocations per minute across the whole run,
+	// forwarded to Runner.RatePerMinute. 0 means unlimited.
+	RateLimit int
+
+	CheckBaseline  bool
+	UpdateBaseline bool
+}
+
+// Run filters, executes, and reports every suite in order, exiting the
+// process on the first failure the way runSuite always did: 1 for a
+// below-threshold suite, 2 for a baseline regression.
+func (sr *SuiteRunner) Run(ctx context.Context, suites ...*skilltests.Suite) {
+	sr.Runner.Parallelism = sr.Concurrency
+	sr.Runner.RatePerMinute = sr.RateLimit
+
+	for _, suite := range suites {
+		sr.runOne(ctx, suite)
+	}
+}
+
+func (sr *SuiteRunner) runOne(ctx context.Context, suite *skilltests.Suite) {
+	// parseFilter gives a bare pattern the same regexp on both axes; such a
+	// pattern lets a suite through when either its name or a case's matches.
+	bare := sr.Filter.SuiteRE != nil && sr.Filter.SuiteRE == sr.Filter.CaseRE
+	suiteMatch := sr.Filter.MatchesSuite(suite.Name)
+	if !suiteMatch && !bare {
+		fmt.Printf("\nSkipping suite %q (doesn't match -run)\n", suite.Name)
+		return
+	}
+
+	var skipped []string
+	if !(bare && suiteMatch) {
+		suite.Cases, skipped = sr.Filter.FilterCases(suite.Cases)
+	}
+
+	printSuiteHeader(suite, skipped)
+	if len(suite.Cases) == 0 {
+		fmt.Println("No cases match -run; nothing to run.")
+		return
+	}
+
+	result, err := sr.Runner.RunSuite(ctx, suite)
+	if err != nil {
+		fmt.Printf("Suite execution failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	printSuiteResult(result, skipped)
+	saveSuiteResult(sr.Runner, result, suite.Name, skipped, sr.Formats)
+
+	if sr.CheckBaseline || sr.UpdateBaseline {
+		checkSuiteBaseline(sr.Runner, result, suite.Name, sr.CheckBaseline, sr.UpdateBaseline)
+	}
+
+	if result.Score < 0.70 {
+		fmt.Printf("\n❌ Suite failed: %.0f%% < 70%% threshold\n", result.Score*100)
+		os.Exit(1)
+	}
+
+	fmt.Printf("\n✅ Suite passed!\n")
+}
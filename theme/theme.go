@@ -0,0 +1,378 @@
+// Package theme provides a shared palette and a small set of common styles
+// (input boxes, header/footer bars, shortcut labels) that scaffolds and
+// reports can resolve colors through instead of redeclaring their own
+// `formColorGold`-style package vars.
+//
+// Unlike the adaptive palette in skills/framework-bubbletea/scaffolds/theme
+// (which targets light/dark terminal detection for that scaffold's own
+// render helpers), this package is a plain dependency-injected value: a
+// model stores a *Theme and passes it along, rather than reading a global.
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// Theme is a semantic set of colors and the shared styles built from them.
+// The accessor methods build the shared styles from the fields, so a new
+// built-in theme only needs to fill in the fields; callers also read the
+// colors directly for their own one-off styles.
+type Theme struct {
+	Primary       lipgloss.Color
+	Accent        lipgloss.Color
+	Muted         lipgloss.Color
+	Error         lipgloss.Color
+	Success       lipgloss.Color
+	Pending       lipgloss.Color
+	Running       lipgloss.Color
+	Background    lipgloss.Color
+	BackgroundAlt lipgloss.Color
+	Border        lipgloss.Color
+	BorderFocused lipgloss.Color
+
+	Icons Icons
+}
+
+// Icons is the set of glyphs k9s-style scaffolds render for state and
+// selection, overridable independently of color so a theme.yaml can swap
+// e.g. nerd-font glyphs in without touching the palette.
+type Icons struct {
+	Check     string
+	Cross     string
+	Circle    string
+	CircleO   string
+	Diamond   string
+	TriangleR string
+	Sparkle   string
+	Bullet    string
+}
+
+func defaultIcons() Icons {
+	return Icons{
+		Check:     "✓",
+		Cross:     "✗",
+		Circle:    "●",
+		CircleO:   "○",
+		Diamond:   "◆",
+		TriangleR: "▸",
+		Sparkle:   "✦",
+		Bullet:    "•",
+	}
+}
+
+// Default is the gold/black palette the k9s-style scaffolds used before
+// theming existed, so passing it explicitly (or leaving a *Theme field nil
+// and falling back to it) is a no-op for existing call sites.
+func Default() *Theme {
+	return &Theme{
+		Primary:       lipgloss.Color("#FFD700"),
+		Accent:        lipgloss.Color("#00D9FF"),
+		Muted:         lipgloss.Color("#6B6B6B"),
+		Error:         lipgloss.Color("#FF073A"),
+		Success:       lipgloss.Color("#39FF14"),
+		Pending:       lipgloss.Color("#6B6B6B"),
+		Running:       lipgloss.Color("#39FF14"),
+		Background:    lipgloss.Color("#0A0A0A"),
+		BackgroundAlt: lipgloss.Color("#1A1A1A"),
+		Border:        lipgloss.Color("#252525"),
+		BorderFocused: lipgloss.Color("#FFD700"),
+		Icons:         defaultIcons(),
+	}
+}
+
+// Dracula is the Dracula palette.
+func Dracula() *Theme {
+	return &Theme{
+		Primary:       lipgloss.Color("#BD93F9"),
+		Accent:        lipgloss.Color("#8BE9FD"),
+		Muted:         lipgloss.Color("#6272A4"),
+		Error:         lipgloss.Color("#FF5555"),
+		Success:       lipgloss.Color("#50FA7B"),
+		Pending:       lipgloss.Color("#6272A4"),
+		Running:       lipgloss.Color("#50FA7B"),
+		Background:    lipgloss.Color("#282A36"),
+		BackgroundAlt: lipgloss.Color("#343746"),
+		Border:        lipgloss.Color("#44475A"),
+		BorderFocused: lipgloss.Color("#BD93F9"),
+		Icons:         defaultIcons(),
+	}
+}
+
+// Solarized is the (dark) Solarized palette.
+func Solarized() *Theme {
+	return &Theme{
+		Primary:       lipgloss.Color("#B58900"),
+		Accent:        lipgloss.Color("#268BD2"),
+		Muted:         lipgloss.Color("#93A1A1"),
+		Error:         lipgloss.Color("#DC322F"),
+		Success:       lipgloss.Color("#859900"),
+		Pending:       lipgloss.Color("#93A1A1"),
+		Running:       lipgloss.Color("#859900"),
+		Background:    lipgloss.Color("#002B36"),
+		BackgroundAlt: lipgloss.Color("#073642"),
+		Border:        lipgloss.Color("#073642"),
+		BorderFocused: lipgloss.Color("#B58900"),
+		Icons:         defaultIcons(),
+	}
+}
+
+// SolarizedLight is the light variant of Solarized, for terminals with a
+// light background where Solarized's dark palette reads as low-contrast.
+func SolarizedLight() *Theme {
+	return &Theme{
+		Primary:       lipgloss.Color("#B58900"),
+		Accent:        lipgloss.Color("#268BD2"),
+		Muted:         lipgloss.Color("#657B83"),
+		Error:         lipgloss.Color("#DC322F"),
+		Success:       lipgloss.Color("#859900"),
+		Pending:       lipgloss.Color("#657B83"),
+		Running:       lipgloss.Color("#859900"),
+		Background:    lipgloss.Color("#FDF6E3"),
+		BackgroundAlt: lipgloss.Color("#EEE8D5"),
+		Border:        lipgloss.Color("#EEE8D5"),
+		BorderFocused: lipgloss.Color("#B58900"),
+		Icons:         defaultIcons(),
+	}
+}
+
+// Adaptive picks Default() or SolarizedLight() based on r's detected
+// background, so a caller that already built a *lipgloss.Renderer (to
+// query HasDarkBackground itself) doesn't have to duplicate that
+// detection to also pick a Theme.
+func Adaptive(r *lipgloss.Renderer) *Theme {
+	if r.HasDarkBackground() {
+		return Default()
+	}
+	return SolarizedLight()
+}
+
+// NoColor disables coloring entirely: every color field is the empty
+// lipgloss.Color(""), so styles built from it render with no color escapes.
+// Pass this to report output headed for a file or a non-TTY pipe.
+func NoColor() *Theme {
+	none := lipgloss.Color("")
+	return &Theme{
+		Primary:       none,
+		Accent:        none,
+		Muted:         none,
+		Error:         none,
+		Success:       none,
+		Pending:       none,
+		Running:       none,
+		Background:    none,
+		BackgroundAlt: none,
+		Border:        none,
+		BorderFocused: none,
+		Icons:         defaultIcons(),
+	}
+}
+
+// InputStyle is an unfocused, non-erroring input box.
+func (t *Theme) InputStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder()).
+		BorderForeground(t.Border).
+		Padding(0, 1)
+}
+
+// InputFocusedStyle is the focused variant of InputStyle.
+func (t *Theme) InputFocusedStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.BorderFocused).
+		Padding(0, 1)
+}
+
+// InputErrorStyle is the variant of InputStyle shown while a field's value
+// fails validation.
+func (t *Theme) InputErrorStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Error).
+		Padding(0, 1)
+}
+
+// HeaderStyle is the full-width bar a screen renders its title/breadcrumb
+// into.
+func (t *Theme) HeaderStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Background(t.BackgroundAlt).
+		Padding(0, 1)
+}
+
+// FooterStyle is the full-width bar a screen renders its shortcut hints
+// into.
+func (t *Theme) FooterStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Background(t.BackgroundAlt).
+		Padding(0, 1)
+}
+
+// ShortcutKeyStyle is the "<key>" label in a footer shortcut hint.
+func (t *Theme) ShortcutKeyStyle() lipgloss.Style {
+	return lipgloss.NewStyle().
+		Foreground(t.Primary).
+		Bold(true)
+}
+
+// hexColor matches a lipgloss-acceptable #rrggbb or #rgb hex color.
+var hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{3}$|^#[0-9a-fA-F]{6}$`)
+
+// yamlTheme mirrors theme.yaml's (and theme.json's) on-disk schema: every
+// field is optional, and only the ones present override Default()'s value,
+// so a user's file can restyle just "error" without having to restate the
+// whole palette.
+type yamlTheme struct {
+	Accent     string `yaml:"accent" json:"accent"`
+	Background string `yaml:"background" json:"background"`
+	Success    string `yaml:"success" json:"success"`
+	Error      string `yaml:"error" json:"error"`
+	Pending    string `yaml:"pending" json:"pending"`
+	Running    string `yaml:"running" json:"running"`
+	Text       struct {
+		Primary string `yaml:"primary" json:"primary"`
+		Muted   string `yaml:"muted" json:"muted"`
+	} `yaml:"text" json:"text"`
+	Icons struct {
+		Check     string `yaml:"check" json:"check"`
+		Cross     string `yaml:"cross" json:"cross"`
+		Circle    string `yaml:"circle" json:"circle"`
+		CircleO   string `yaml:"circleO" json:"circleO"`
+		Diamond   string `yaml:"diamond" json:"diamond"`
+		TriangleR string `yaml:"triangleR" json:"triangleR"`
+		Sparkle   string `yaml:"sparkle" json:"sparkle"`
+		Bullet    string `yaml:"bullet" json:"bullet"`
+	} `yaml:"icons" json:"icons"`
+}
+
+// DefaultPath is the file Load reads: ~/.claude/theme.yaml.
+func DefaultPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".claude", "theme.yaml")
+}
+
+// Load reads the theme at DefaultPath, falling back to Default() if it
+// doesn't exist.
+func Load() (*Theme, error) {
+	return LoadTheme(DefaultPath())
+}
+
+// LoadTheme reads a theme.yaml at path and merges it over Default(),
+// falling back to Default() untouched when path doesn't exist. A color
+// value that isn't a valid #rgb/#rrggbb hex code is reported with the
+// offending key so the user knows exactly what to fix.
+func LoadTheme(path string) (*Theme, error) {
+	t := Default()
+	if path == "" {
+		return t, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("theme: reading %s: %w", path, err)
+	}
+
+	var y yamlTheme
+	if err := yaml.Unmarshal(data, &y); err != nil {
+		return nil, fmt.Errorf("theme: parsing %s: %w", path, err)
+	}
+
+	return mergeYAMLTheme(t, y)
+}
+
+// LoadThemeJSON reads a theme.json at path and merges it over Default(),
+// the same partial-override semantics as LoadTheme but for users who'd
+// rather write their palette as JSON than YAML. Only path is read; no
+// default location is searched for a JSON theme.
+// Falls back to Default() untouched when path doesn't exist.
+func LoadThemeJSON(path string) (*Theme, error) {
+	t := Default()
+	if path == "" {
+		return t, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("theme: reading %s: %w", path, err)
+	}
+
+	var y yamlTheme
+	if err := json.Unmarshal(data, &y); err != nil {
+		return nil, fmt.Errorf("theme: parsing %s: %w", path, err)
+	}
+
+	return mergeYAMLTheme(t, y)
+}
+
+// mergeYAMLTheme overlays y's non-empty fields onto t, shared by LoadTheme
+// and LoadThemeJSON since theme.yaml and theme.json describe the same
+// schema. A color value that isn't a valid #rgb/#rrggbb hex code is
+// reported with the offending key so the user knows exactly what to fix.
+func mergeYAMLTheme(t *Theme, y yamlTheme) (*Theme, error) {
+	fields := []struct {
+		key   string
+		value string
+		dest  *lipgloss.Color
+	}{
+		{"accent", y.Accent, &t.Accent},
+		{"background", y.Background, &t.Background},
+		{"success", y.Success, &t.Success},
+		{"error", y.Error, &t.Error},
+		{"pending", y.Pending, &t.Pending},
+		{"running", y.Running, &t.Running},
+		{"text.primary", y.Text.Primary, &t.Primary},
+		{"text.muted", y.Text.Muted, &t.Muted},
+	}
+	for _, f := range fields {
+		if f.value == "" {
+			continue
+		}
+		if !hexColor.MatchString(f.value) {
+			return nil, fmt.Errorf("theme: %s: %q is not a valid hex color (want #rgb or #rrggbb)", f.key, f.value)
+		}
+		*f.dest = lipgloss.Color(f.value)
+	}
+
+	if y.Icons.Check != "" {
+		t.Icons.Check = y.Icons.Check
+	}
+	if y.Icons.Cross != "" {
+		t.Icons.Cross = y.Icons.Cross
+	}
+	if y.Icons.Circle != "" {
+		t.Icons.Circle = y.Icons.Circle
+	}
+	if y.Icons.CircleO != "" {
+		t.Icons.CircleO = y.Icons.CircleO
+	}
+	if y.Icons.Diamond != "" {
+		t.Icons.Diamond = y.Icons.Diamond
+	}
+	if y.Icons.TriangleR != "" {
+		t.Icons.TriangleR = y.Icons.TriangleR
+	}
+	if y.Icons.Sparkle != "" {
+		t.Icons.Sparkle = y.Icons.Sparkle
+	}
+	if y.Icons.Bullet != "" {
+		t.Icons.Bullet = y.Icons.Bullet
+	}
+
+	return t, nil
+}